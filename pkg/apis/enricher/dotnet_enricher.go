@@ -40,7 +40,7 @@ func (d DotNetEnricher) DoEnrichLanguage(language *model.Language, files *[]stri
 	}
 }
 
-// DoEnrichComponent checks for the port number using a Dockerfile or Compose file
+// DoEnrichComponent checks for the port number using a Dockerfile, Compose file, or Source strategy
 func (d DotNetEnricher) DoEnrichComponent(component *model.Component, settings model.DetectionSettings, ctx *context.Context) {
 	projectName := GetDefaultProjectName(component.Path)
 	component.Name = projectName
@@ -58,6 +58,12 @@ func (d DotNetEnricher) DoEnrichComponent(component *model.Component, settings m
 				ports = GetPortsFromDockerComposeFile(component.Path, settings)
 				break
 			}
+		case model.Source:
+			{
+				for _, detector := range getDotNetFrameworkDetectors() {
+					detector.DoPortsDetection(component, ctx)
+				}
+			}
 		}
 		if len(ports) > 0 {
 			component.Ports = ports
@@ -37,6 +37,32 @@ type Enricher interface {
 	IsConfigValidForComponentDetection(language string, configFile string) bool
 }
 
+// ComponentSplitter is implemented by the enrichers of the languages whose configuration file can define several
+// components, e.g. a Go module with many main packages
+type ComponentSplitter interface {
+	SplitComponent(component model.Component, ctx *context.Context) []model.Component
+}
+
+// ComponentConfigValidator is implemented by the enrichers which read other files of the build to validate a
+// configuration file, e.g. the settings file of a Gradle multi-project build. Those files are cached inside ctx
+// for the whole analysis.
+type ComponentConfigValidator interface {
+	IsConfigValidForComponentDetectionWithContext(language string, configFile string, ctx *context.Context) bool
+}
+
+// LanguageEnricherWithContext is implemented by the enrichers which read the source files to detect the frameworks
+// of a language, e.g. the Go files starting a net/http server. Those files are cached inside ctx for the whole
+// analysis.
+type LanguageEnricherWithContext interface {
+	DoEnrichLanguageWithContext(language *model.Language, files *[]string, ctx *context.Context)
+}
+
+// FrameworkDetectorWithDefaultPort is implemented by the detectors of frameworks which listen on a well-known port
+// when none is configured, e.g. 5173 for Vite. The default port is used only if no detector found a port.
+type FrameworkDetectorWithDefaultPort interface {
+	GetDefaultPort(componentPath string) int
+}
+
 type FrameworkDetectorWithConfigFile interface {
 	GetSupportedFrameworks() []string
 	DoFrameworkDetection(language *model.Language, config string)
@@ -89,6 +115,9 @@ func getEnrichers() []Enricher {
 		&DotNetEnricher{},
 		&GoEnricher{},
 		&PHPEnricher{},
+		&RubyEnricher{},
+		&RustEnricher{},
+		&ScalaEnricher{},
 		&DockerEnricher{},
 	}
 }
@@ -31,9 +31,18 @@ func (d DotNetDetector) GetSupportedFrameworks() []string {
 	return []string{""}
 }
 
+// GetApplicationFileInfos returns the Program file of the component, which may configure the urls of the server
 func (d DotNetDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
-	// not implemented yet
-	return []model.ApplicationFileInfo{}
+	var appFileInfos []model.ApplicationFileInfo
+	for _, file := range []string{"Program.cs", "Program.fs", "Program.vb"} {
+		appFileInfos = append(appFileInfos, model.ApplicationFileInfo{
+			Context: ctx,
+			Root:    componentPath,
+			Dir:     "",
+			File:    file,
+		})
+	}
+	return appFileInfos
 }
 
 // DoFrameworkDetection uses configFilePath to check for the name of the framework
@@ -56,8 +65,33 @@ func (d DotNetDetector) DoFrameworkDetection(language *model.Language, configFil
 	}
 }
 
+// DoPortsDetection searches for the ports following the precedence of ASP.NET Core: the Kestrel endpoints of the
+// appsettings*.json files, the urls set inside the Program file, the ASPNETCORE_URLS and ASPNETCORE_HTTP_PORTS env vars
+// and finally the applicationUrl of the launch profiles inside Properties/launchSettings.json
 func (d DotNetDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
-	// not implemented yet
+	// case: port is set as Kestrel endpoint inside appsettings.json
+	if ports := getPortsFromKestrelEndpoints(component.Path); len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+	// case: port is set inside the Program file
+	if fileContents, err := utils.GetApplicationFileContents(d.GetApplicationFileInfos(component.Path, ctx)); err == nil {
+		for _, fileContent := range fileContents {
+			if ports := getPortsFromProgramFile(fileContent); len(ports) > 0 {
+				component.Ports = ports
+				return
+			}
+		}
+	}
+	// case: port is set on env var, in the system or inside the Dockerfile
+	if ports := getPortsFromAspNetCoreEnvs(component.Path); len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+	// case: port is set inside launchSettings.json
+	if ports := getPortsFromLaunchSettings(component.Path); len(ports) > 0 {
+		component.Ports = ports
+	}
 }
 
 func getFrameworks(configFilePath string) string {
@@ -0,0 +1,247 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/schema"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+var (
+	// aspNetCoreUrlRegex matches the port of a url, e.g. http://+:8080 or https://localhost:5001/
+	aspNetCoreUrlRegex = regexp.MustCompile(`^\s*\w+://[^/]*:(\d+)/?\s*$`)
+	// useUrlsRegex matches the urls passed to UseUrls, e.g. .UseUrls("http://*:5000", "https://*:5001")
+	useUrlsRegex = regexp.MustCompile(`\.UseUrls\(([^)]*)\)`)
+	// runUrlRegex matches the url passed to the run methods of a web application or added to its urls,
+	// e.g. app.Run("http://localhost:3000") or app.Urls.Add("http://*:3000")
+	runUrlRegex = regexp.MustCompile(`\.(?:Run|RunAsync|Urls\.Add)\(\s*"([^"]+)"`)
+	// listenRegex matches the ports Kestrel listens on, e.g. options.ListenAnyIP(5000) or options.Listen(IPAddress.Any, 5000)
+	listenRegex = regexp.MustCompile(`\.(?:ListenAnyIP|ListenLocalhost)\(\s*(\d+)|\.Listen\(\s*IPAddress\.\w+\s*,\s*(\d+)`)
+	// quotedRegex matches a double-quoted string
+	quotedRegex = regexp.MustCompile(`"([^"]*)"`)
+)
+
+// getPortsFromKestrelEndpoints returns the ports of the Kestrel:Endpoints urls of the appsettings.json file and of its
+// environment-specific variants (e.g. appsettings.Development.json)
+func getPortsFromKestrelEndpoints(root string) []int {
+	settingsFiles, err := filepath.Glob(filepath.Join(root, "appsettings*.json"))
+	if err != nil {
+		return []int{}
+	}
+	// appsettings.json comes first, followed by its variants
+	sort.SliceStable(settingsFiles, func(i, j int) bool {
+		return filepath.Base(settingsFiles[i]) == "appsettings.json" && filepath.Base(settingsFiles[j]) != "appsettings.json"
+	})
+
+	var ports []int
+	for _, settingsFile := range settingsFiles {
+		var appSettings schema.DotNetAppSettings
+		if err := readDotNetJsonFile(settingsFile, &appSettings); err != nil {
+			continue
+		}
+		var names []string
+		for name := range appSettings.Kestrel.Endpoints {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			ports = appendPortsFromUrls(ports, appSettings.Kestrel.Endpoints[name].Url)
+		}
+	}
+	return ports
+}
+
+// getPortsFromProgramFile returns the ports set by the UseUrls, Listen and Run calls of the Program file
+func getPortsFromProgramFile(content string) []int {
+	var ports []int
+	for _, line := range strings.Split(content, "\n") {
+		if trimmedLine := strings.TrimSpace(line); strings.HasPrefix(trimmedLine, "//") || strings.HasPrefix(trimmedLine, "'") {
+			continue
+		}
+		for _, match := range useUrlsRegex.FindAllStringSubmatch(line, -1) {
+			for _, url := range quotedRegex.FindAllStringSubmatch(match[1], -1) {
+				ports = appendPortsFromUrls(ports, url[1])
+			}
+		}
+		for _, match := range runUrlRegex.FindAllStringSubmatch(line, -1) {
+			ports = appendPortsFromUrls(ports, match[1])
+		}
+		for _, match := range listenRegex.FindAllStringSubmatch(line, -1) {
+			for _, group := range match[1:] {
+				if port, err := utils.GetValidPort(group); err == nil {
+					ports = utils.AppendPortIfMissing(ports, port)
+				}
+			}
+		}
+	}
+	return ports
+}
+
+// getPortsFromAspNetCoreEnvs returns the ports of the ASPNETCORE_URLS and ASPNETCORE_HTTP_PORTS env vars,
+// set in the system or inside the Dockerfile of root
+func getPortsFromAspNetCoreEnvs(root string) []int {
+	envs := map[string]string{}
+	if envVars, err := utils.GetEnvVarsFromDockerFile(root); err == nil {
+		for _, envVar := range envVars {
+			envs[envVar.Name] = envVar.Value
+		}
+	}
+	for _, name := range []string{"ASPNETCORE_URLS", "ASPNETCORE_HTTP_PORTS"} {
+		if value, found := os.LookupEnv(name); found {
+			envs[name] = value
+		}
+	}
+	return getPortsFromAspNetCoreEnvValues(envs)
+}
+
+// getPortsFromLaunchSettings returns the ports of the launch profiles of Properties/launchSettings.json which run the
+// project, either from their applicationUrl or from their env vars
+func getPortsFromLaunchSettings(root string) []int {
+	var launchSettings schema.DotNetLaunchSettings
+	if err := readDotNetJsonFile(filepath.Join(root, "Properties", "launchSettings.json"), &launchSettings); err != nil {
+		return []int{}
+	}
+	var names []string
+	for name, profile := range launchSettings.Profiles {
+		if profile.CommandName == "Project" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var ports []int
+	for _, name := range names {
+		profile := launchSettings.Profiles[name]
+		ports = appendPortsFromUrls(ports, profile.ApplicationUrl)
+		for _, port := range getPortsFromAspNetCoreEnvValues(profile.EnvironmentVariables) {
+			ports = utils.AppendPortIfMissing(ports, port)
+		}
+	}
+	return ports
+}
+
+// getPortsFromAspNetCoreEnvValues returns the ports of the ASPNETCORE_URLS env var or,
+// if it is not set, of the ASPNETCORE_HTTP_PORTS env var
+func getPortsFromAspNetCoreEnvValues(envs map[string]string) []int {
+	if ports := appendPortsFromUrls([]int{}, envs["ASPNETCORE_URLS"]); len(ports) > 0 {
+		return ports
+	}
+	var ports []int
+	for _, value := range strings.Split(envs["ASPNETCORE_HTTP_PORTS"], ";") {
+		if port, err := utils.GetValidPort(strings.TrimSpace(value)); err == nil {
+			ports = utils.AppendPortIfMissing(ports, port)
+		}
+	}
+	return ports
+}
+
+// appendPortsFromUrls appends the ports of a list of urls separated by semicolons,
+// e.g. https://localhost:5001;http://localhost:5000
+func appendPortsFromUrls(ports []int, urls string) []int {
+	for _, url := range strings.Split(urls, ";") {
+		if port := utils.FindPortSubmatch(aspNetCoreUrlRegex, url, 1); port != -1 {
+			ports = utils.AppendPortIfMissing(ports, port)
+		}
+	}
+	return ports
+}
+
+// readDotNetJsonFile unmarshals a json settings file. As the .NET configuration loader does, it accepts a byte order
+// mark, comments and trailing commas.
+func readDotNetJsonFile(path string, v interface{}) error {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	return json.Unmarshal(removeJsonTrailingCommas(removeJsonComments(content)), v)
+}
+
+// removeJsonComments removes the // and /* */ comments found outside of the strings of a json content
+func removeJsonComments(content []byte) []byte {
+	result := make([]byte, 0, len(content))
+	inString := false
+	for i := 0; i < len(content); i++ {
+		char := content[i]
+		if inString {
+			result = append(result, char)
+			if char == '\\' && i+1 < len(content) {
+				i++
+				result = append(result, content[i])
+			} else if char == '"' {
+				inString = false
+			}
+			continue
+		}
+		if char == '/' && i+1 < len(content) && content[i+1] == '/' {
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+			if i < len(content) {
+				result = append(result, '\n')
+			}
+			continue
+		}
+		if char == '/' && i+1 < len(content) && content[i+1] == '*' {
+			end := bytes.Index(content[i+2:], []byte("*/"))
+			if end == -1 {
+				break
+			}
+			i += end + 3
+			continue
+		}
+		if char == '"' {
+			inString = true
+		}
+		result = append(result, char)
+	}
+	return result
+}
+
+// removeJsonTrailingCommas removes the commas followed by the end of an object or an array
+func removeJsonTrailingCommas(content []byte) []byte {
+	result := make([]byte, 0, len(content))
+	inString := false
+	for i := 0; i < len(content); i++ {
+		char := content[i]
+		if inString {
+			if char == '\\' && i+1 < len(content) {
+				result = append(result, char)
+				i++
+				char = content[i]
+			} else if char == '"' {
+				inString = false
+			}
+			result = append(result, char)
+			continue
+		}
+		if char == ',' {
+			next := bytes.TrimLeft(content[i+1:], " \t\r\n")
+			if len(next) > 0 && (next[0] == '}' || next[0] == ']') {
+				continue
+			}
+		}
+		if char == '"' {
+			inString = true
+		}
+		result = append(result, char)
+	}
+	return result
+}
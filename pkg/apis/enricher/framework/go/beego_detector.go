@@ -26,7 +26,11 @@ func (b BeegoDetector) GetSupportedFrameworks() []string {
 	return []string{"Beego"}
 }
 
+// GetApplicationFileInfos returns the conf/app.conf file of the module the component belongs to
 func (b BeegoDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	if module, found := GetGoModuleOfDir(componentPath); found {
+		componentPath = module.Path
+	}
 	return []model.ApplicationFileInfo{
 		{
 			Context: ctx,
@@ -38,7 +42,7 @@ func (b BeegoDetector) GetApplicationFileInfos(componentPath string, ctx *contex
 }
 
 // DoFrameworkDetection uses a tag to check for the framework name
-func (b BeegoDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File) {
+func (b BeegoDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
 	if hasFramework(goMod.Require, "github.com/beego/beego") {
 		language.Frameworks = append(language.Frameworks, "Beego")
 	}
@@ -0,0 +1,68 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+	"golang.org/x/mod/modfile"
+)
+
+type BuffaloDetector struct{}
+
+func (b BuffaloDetector) GetSupportedFrameworks() []string {
+	return []string{"Buffalo"}
+}
+
+func (b BuffaloDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return GetGoApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (b BuffaloDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
+	if hasFramework(goMod.Require, "github.com/gobuffalo/buffalo") {
+		language.Frameworks = append(language.Frameworks, "Buffalo")
+	}
+}
+
+// DoPortsDetection searches for the Addr of the buffalo.Options and then for the PORT env var, which Buffalo
+// reads from the system, the .env file or the Dockerfile
+func (b BuffaloDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	ports := getGoPortsFromFiles(component.Path, b.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Fields: []string{"Addr"},
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+
+	root := component.Path
+	if module, found := GetGoModuleOfDir(component.Path); found {
+		root = module.Path
+	}
+	// case: port is set on env var
+	if ports := utils.GetValidPortsFromEnvs([]string{"PORT"}); len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+	// case: port is set inside the .env file
+	if ports := utils.GetPortValuesFromEnvFile(root, []string{`PORT=(\d+)`}); len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+	// case: port is set as env var inside the Dockerfile
+	if ports, err := utils.GetEnvVarPortValueFromDockerfile(root, []string{"PORT"}); err == nil && len(ports) > 0 {
+		component.Ports = ports
+	}
+}
@@ -0,0 +1,50 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"golang.org/x/mod/modfile"
+)
+
+type ChiDetector struct{}
+
+func (c ChiDetector) GetSupportedFrameworks() []string {
+	return []string{"Chi"}
+}
+
+func (c ChiDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return GetGoApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (c ChiDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
+	if hasFramework(goMod.Require, "github.com/go-chi/chi") {
+		language.Frameworks = append(language.Frameworks, "Chi")
+	}
+}
+
+// DoPortsDetection searches for the address passed to the net/http server serving the chi router
+func (c ChiDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	ports := getGoPortsFromFiles(component.Path, c.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Calls: []goPortCall{
+			{Name: "ListenAndServe"},
+			{Name: "ListenAndServeTLS"},
+		},
+		Fields: []string{"Addr"},
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
+	}
+}
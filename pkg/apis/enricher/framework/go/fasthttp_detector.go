@@ -13,10 +13,8 @@ package enricher
 
 import (
 	"context"
-	"regexp"
 
 	"github.com/devfile/alizer/pkg/apis/model"
-	"github.com/devfile/alizer/pkg/utils"
 	"golang.org/x/mod/modfile"
 )
 
@@ -27,39 +25,24 @@ func (f FastHttpDetector) GetSupportedFrameworks() []string {
 }
 
 func (f FastHttpDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
-	files, err := utils.GetCachedFilePathsFromRoot(componentPath, ctx)
-	if err != nil {
-		return []model.ApplicationFileInfo{}
-	}
-	return utils.GenerateApplicationFileFromFilters(files, componentPath, ".go", ctx)
+	return GetGoApplicationFileInfos(componentPath, ctx)
 }
 
 // DoFrameworkDetection uses a tag to check for the framework name
-func (f FastHttpDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File) {
+func (f FastHttpDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
 	if hasFramework(goMod.Require, "github.com/valyala/fasthttp") {
 		language.Frameworks = append(language.Frameworks, "FastHttp")
 	}
 }
 
 func (f FastHttpDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
-	fileContents, err := utils.GetApplicationFileContents(f.GetApplicationFileInfos(component.Path, ctx))
-	if err != nil {
-		return
-	}
-
-	matchRegexRules := model.PortMatchRules{
-		MatchIndexRegexes: []model.PortMatchRule{
-			{
-				Regex:     regexp.MustCompile(`.ListenAndServe\([^,)]*`),
-				ToReplace: ".ListenAndServe(",
-			},
+	ports := getGoPortsFromFiles(component.Path, f.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Calls: []goPortCall{
+			{Name: "ListenAndServe"},
+			{Name: "ListenAndServeTLS"},
 		},
-	}
-	for _, fileContent := range fileContents {
-		ports := GetPortFromFileGo(matchRegexRules, fileContent)
-		if len(ports) > 0 {
-			component.Ports = ports
-			return
-		}
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
 	}
 }
@@ -13,10 +13,8 @@ package enricher
 
 import (
 	"context"
-	"regexp"
 
 	"github.com/devfile/alizer/pkg/apis/model"
-	"github.com/devfile/alizer/pkg/utils"
 	"golang.org/x/mod/modfile"
 )
 
@@ -27,40 +25,24 @@ func (g GinDetector) GetSupportedFrameworks() []string {
 }
 
 func (g GinDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
-	files, err := utils.GetCachedFilePathsFromRoot(componentPath, ctx)
-	if err != nil {
-		return []model.ApplicationFileInfo{}
-	}
-	return utils.GenerateApplicationFileFromFilters(files, componentPath, ".go", ctx)
+	return GetGoApplicationFileInfos(componentPath, ctx)
 }
 
 // DoFrameworkDetection uses a tag to check for the framework name
-func (g GinDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File) {
+func (g GinDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
 	if hasFramework(goMod.Require, "github.com/gin-gonic/gin") {
 		language.Frameworks = append(language.Frameworks, "Gin")
 	}
 }
 
 func (g GinDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
-	fileContents, err := utils.GetApplicationFileContents(g.GetApplicationFileInfos(component.Path, ctx))
-	if err != nil {
-		return
-	}
-
-	matchRegexRules := model.PortMatchRules{
-		MatchIndexRegexes: []model.PortMatchRule{
-			{
-				Regex:     regexp.MustCompile(`.Run\(([^,)]*)`),
-				ToReplace: ".Run(",
-			},
+	ports := getGoPortsFromFiles(component.Path, g.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Calls: []goPortCall{
+			{Name: "Run"},
+			{Name: "RunTLS"},
 		},
-	}
-
-	for _, fileContent := range fileContents {
-		ports := GetPortFromFileGo(matchRegexRules, fileContent)
-		if len(ports) > 0 {
-			component.Ports = ports
-			return
-		}
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
 	}
 }
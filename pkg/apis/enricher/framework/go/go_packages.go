@@ -0,0 +1,202 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+// goBuildContext evaluates the build constraints of the .go files (e.g. //go:build ignore or _windows.go suffixes)
+// for linux/amd64, the platform of the containers components usually run in
+var goBuildContext = func() build.Context {
+	buildContext := build.Default
+	buildContext.GOOS = "linux"
+	buildContext.GOARCH = "amd64"
+	buildContext.CgoEnabled = true
+	return buildContext
+}()
+
+// goPackage is a package of a Go module, made of the .go files of a folder
+type goPackage struct {
+	Name    string
+	Files   []string
+	Imports []string
+}
+
+// GetGoMainPackages returns the sorted folders of the main packages of the module inside modulePath
+func GetGoMainPackages(modulePath string, ctx *context.Context) []string {
+	module, found := GetGoModule(modulePath)
+	if !found {
+		return []string{}
+	}
+	return getGoMainPackageDirs(getGoPackages(module.Path, getGoModuleFiles(module.Path, ctx)))
+}
+
+// GetGoApplicationFileInfos returns the .go files of the component inside componentPath. If the component is a main
+// package, or a module with a single main package, only the files reachable from the main package through its imports
+// are returned. Otherwise, all the files of the module are returned, leaving out the files of the modules nested inside
+// it, which are distinct components.
+func GetGoApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	module, found := GetGoModuleOfDir(componentPath)
+	if !found {
+		files, err := utils.GetCachedFilePathsFromRoot(componentPath, ctx)
+		if err != nil {
+			return []model.ApplicationFileInfo{}
+		}
+		return utils.GenerateApplicationFileFromFilters(files, componentPath, ".go", ctx)
+	}
+
+	files := getGoModuleFiles(module.Path, ctx)
+	packages := getGoPackages(module.Path, files)
+	dir, _ := filepath.Abs(componentPath)
+	mainDir := ""
+	if pkg, isPackage := packages[dir]; isPackage && pkg.Name == "main" {
+		mainDir = dir
+	} else if mainDirs := getGoMainPackageDirs(packages); dir == module.Path && len(mainDirs) == 1 {
+		mainDir = mainDirs[0]
+	}
+	if mainDir != "" {
+		files = getGoReachableFiles(module, mainDir, packages)
+	}
+	return utils.GenerateApplicationFileFromFilters(files, module.Path, ".go", ctx)
+}
+
+// getGoModuleFiles returns the files of the module inside modulePath, leaving out the files of the modules nested
+// inside it
+func getGoModuleFiles(modulePath string, ctx *context.Context) []string {
+	files, err := utils.GetCachedFilePathsFromRoot(modulePath, ctx)
+	if err != nil {
+		return []string{}
+	}
+	var nestedModuleDirs []string
+	for _, file := range files {
+		if dir := filepath.Dir(file); filepath.Base(file) == "go.mod" && filepath.Clean(dir) != filepath.Clean(modulePath) {
+			nestedModuleDirs = append(nestedModuleDirs, dir+string(os.PathSeparator))
+		}
+	}
+	var moduleFiles []string
+	for _, file := range files {
+		if !isFileInAnyDir(file, nestedModuleDirs) {
+			moduleFiles = append(moduleFiles, file)
+		}
+	}
+	return moduleFiles
+}
+
+// getGoPackages returns the packages of the .go files of the module inside modulePath, by folder. Test files, the
+// vendor and testdata folders of the module and the files excluded by their build constraints (e.g. a generator with
+// //go:build ignore) are ignored.
+func getGoPackages(modulePath string, files []string) map[string]*goPackage {
+	packages := map[string]*goPackage{}
+	fileSet := token.NewFileSet()
+	for _, file := range files {
+		if !strings.HasSuffix(file, ".go") || strings.HasSuffix(file, "_test.go") || isInIgnoredGoFolder(modulePath, file) {
+			continue
+		}
+		if match, err := goBuildContext.MatchFile(filepath.Dir(file), filepath.Base(file)); err != nil || !match {
+			continue
+		}
+		parsedFile, err := parser.ParseFile(fileSet, file, nil, parser.ImportsOnly)
+		if err != nil {
+			continue
+		}
+		dir, err := filepath.Abs(filepath.Dir(file))
+		if err != nil {
+			continue
+		}
+		pkg, found := packages[dir]
+		if !found {
+			pkg = &goPackage{Name: parsedFile.Name.Name}
+			packages[dir] = pkg
+		}
+		pkg.Files = append(pkg.Files, file)
+		for _, importSpec := range parsedFile.Imports {
+			if importPath, err := strconv.Unquote(importSpec.Path.Value); err == nil {
+				pkg.Imports = append(pkg.Imports, importPath)
+			}
+		}
+	}
+	return packages
+}
+
+// getGoMainPackageDirs returns the sorted folders of the main packages
+func getGoMainPackageDirs(packages map[string]*goPackage) []string {
+	mainDirs := []string{}
+	for dir, pkg := range packages {
+		if pkg.Name == "main" {
+			mainDirs = append(mainDirs, dir)
+		}
+	}
+	sort.Strings(mainDirs)
+	return mainDirs
+}
+
+// getGoReachableFiles returns the files of the package inside dir and of the packages of the module it imports,
+// directly or not
+func getGoReachableFiles(module GoModule, dir string, packages map[string]*goPackage) []string {
+	var files []string
+	visited := map[string]bool{}
+	toVisit := []string{dir}
+	for len(toVisit) > 0 {
+		current := toVisit[0]
+		toVisit = toVisit[1:]
+		pkg, found := packages[current]
+		if visited[current] || !found {
+			continue
+		}
+		visited[current] = true
+		files = append(files, pkg.Files...)
+		for _, importPath := range pkg.Imports {
+			if importPath == module.Module {
+				toVisit = append(toVisit, module.Path)
+			} else if relativePath, isModuleImport := strings.CutPrefix(importPath, module.Module+"/"); isModuleImport {
+				toVisit = append(toVisit, filepath.Join(module.Path, filepath.FromSlash(relativePath)))
+			}
+		}
+	}
+	return files
+}
+
+// isInIgnoredGoFolder checks if file is inside a vendor or testdata folder of the module inside modulePath. The
+// folders above the module are not considered.
+func isInIgnoredGoFolder(modulePath string, file string) bool {
+	relativeDir, err := filepath.Rel(modulePath, filepath.Dir(file))
+	if err != nil {
+		return false
+	}
+	for _, folder := range strings.Split(filepath.ToSlash(relativeDir), "/") {
+		if folder == "vendor" || folder == "testdata" {
+			return true
+		}
+	}
+	return false
+}
+
+func isFileInAnyDir(file string, dirs []string) bool {
+	for _, dir := range dirs {
+		if strings.HasPrefix(file, dir) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,428 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+var (
+	// goFormatVerbRegex matches the verbs of a fmt format, e.g. %d or %v
+	goFormatVerbRegex = regexp.MustCompile(`%[-+# 0-9.]*[vdsq]`)
+	// goHostPortRegex matches the port of a host:port address, e.g. 0.0.0.0:8080
+	goHostPortRegex = regexp.MustCompile(`:(\d+)$`)
+)
+
+// goMaxResolveDepth is the maximum number of identifiers followed to resolve a value
+const goMaxResolveDepth = 10
+
+// goPortCall is a function or method call receiving an address or a port, e.g. router.Run(":8080") for Gin
+type goPortCall struct {
+	Name     string
+	ArgIndex int
+}
+
+// goPortRules are the calls and the struct fields holding the address or the port of a Go server
+type goPortRules struct {
+	Calls  []goPortCall
+	Fields []string
+}
+
+// goPackageScope holds the declarations of a package used to resolve the value of an expression
+type goPackageScope struct {
+	root   string
+	values map[string][]ast.Expr
+	funcs  map[string]*ast.FuncDecl
+}
+
+// getGoPortsFromFiles parses the application files and returns the ports of the first file matching the rules.
+// Values are resolved through the constants and variables of the package and of the enclosing function,
+// fmt.Sprintf and strconv calls, the defaults of the flags and the env vars, set in the system or inside the
+// Dockerfile of root, with their fallback values.
+func getGoPortsFromFiles(root string, appFileInfos []model.ApplicationFileInfo, rules goPortRules) []int {
+	var dirs []string
+	filesByDir := map[string][]string{}
+	for _, appFileInfo := range appFileInfos {
+		file := filepath.Join(appFileInfo.Root, appFileInfo.Dir, appFileInfo.File)
+		dir := filepath.Dir(file)
+		if _, found := filesByDir[dir]; !found {
+			dirs = append(dirs, dir)
+		}
+		filesByDir[dir] = append(filesByDir[dir], file)
+	}
+
+	for _, dir := range dirs {
+		fileSet := token.NewFileSet()
+		var parsedFiles []*ast.File
+		for _, file := range filesByDir[dir] {
+			if parsedFile, err := parser.ParseFile(fileSet, file, nil, 0); err == nil {
+				parsedFiles = append(parsedFiles, parsedFile)
+			}
+		}
+		scope := newGoPackageScope(root, parsedFiles)
+		for _, parsedFile := range parsedFiles {
+			if ports := scope.getPortsFromFile(parsedFile, rules); len(ports) > 0 {
+				return ports
+			}
+		}
+	}
+	return []int{}
+}
+
+// newGoPackageScope collects the package-level constants, variables and functions of the files of a package
+func newGoPackageScope(root string, files []*ast.File) *goPackageScope {
+	scope := &goPackageScope{
+		root:   root,
+		values: map[string][]ast.Expr{},
+		funcs:  map[string]*ast.FuncDecl{},
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				addGoValueSpecs(scope.values, decl)
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					scope.funcs[decl.Name.Name] = decl
+				}
+			}
+		}
+		addGoFlagVars(scope.values, file)
+	}
+	return scope
+}
+
+// getPortsFromFile returns the ports set by the calls and the fields of the rules inside file
+func (s *goPackageScope) getPortsFromFile(file *ast.File, rules goPortRules) []int {
+	var ports []int
+	for _, decl := range file.Decls {
+		locals := map[string][]ast.Expr{}
+		if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Body != nil {
+			locals = getGoLocalValues(funcDecl.Body)
+		}
+		ast.Inspect(decl, func(node ast.Node) bool {
+			for _, expr := range getGoPortExprs(node, rules) {
+				if value, resolved := s.resolve(expr, locals, 0); resolved {
+					if port := getPortFromGoValue(value); port != -1 {
+						ports = utils.AppendPortIfMissing(ports, port)
+					}
+				}
+			}
+			return true
+		})
+	}
+	return ports
+}
+
+// getGoPortExprs returns the expressions holding an address or a port inside node, according to the rules
+func getGoPortExprs(node ast.Node, rules goPortRules) []ast.Expr {
+	var exprs []ast.Expr
+	switch node := node.(type) {
+	case *ast.CallExpr:
+		// the rules match the function or method name, whatever its package or receiver
+		name := getGoCallName(node)
+		name = name[strings.LastIndex(name, ".")+1:]
+		for _, call := range rules.Calls {
+			if call.Name == name && call.ArgIndex < len(node.Args) {
+				exprs = append(exprs, node.Args[call.ArgIndex])
+			}
+		}
+	case *ast.KeyValueExpr:
+		if key, ok := node.Key.(*ast.Ident); ok && utils.Contains(rules.Fields, key.Name) {
+			exprs = append(exprs, node.Value)
+		}
+	case *ast.AssignStmt:
+		// e.g. server.Addr = ":8080"
+		for index, lhs := range node.Lhs {
+			if selector, ok := lhs.(*ast.SelectorExpr); ok && utils.Contains(rules.Fields, selector.Sel.Name) && index < len(node.Rhs) {
+				exprs = append(exprs, node.Rhs[index])
+			}
+		}
+	}
+	return exprs
+}
+
+// resolve returns the string value of expr. Numbers are returned in their decimal form.
+func (s *goPackageScope) resolve(expr ast.Expr, locals map[string][]ast.Expr, depth int) (string, bool) {
+	if depth > goMaxResolveDepth {
+		return "", false
+	}
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind == token.STRING {
+			value, err := strconv.Unquote(expr.Value)
+			return value, err == nil
+		}
+		if expr.Kind == token.INT {
+			return expr.Value, true
+		}
+	case *ast.ParenExpr:
+		return s.resolve(expr.X, locals, depth)
+	case *ast.StarExpr:
+		return s.resolve(expr.X, locals, depth)
+	case *ast.UnaryExpr:
+		if expr.Op == token.AND {
+			return s.resolve(expr.X, locals, depth)
+		}
+	case *ast.BinaryExpr:
+		if expr.Op == token.ADD {
+			left, leftResolved := s.resolve(expr.X, locals, depth+1)
+			right, rightResolved := s.resolve(expr.Y, locals, depth+1)
+			return left + right, leftResolved && rightResolved
+		}
+	case *ast.Ident:
+		for _, values := range []map[string][]ast.Expr{locals, s.values} {
+			for _, value := range values[expr.Name] {
+				if resolved, ok := s.resolve(value, locals, depth+1); ok {
+					return resolved, true
+				}
+			}
+		}
+	case *ast.CallExpr:
+		return s.resolveCall(expr, locals, depth)
+	}
+	return "", false
+}
+
+// resolveCall returns the string value returned by a call, for the fmt, strconv, os, flag and cmp functions,
+// the conversions and the functions of the package reading an env var with a fallback value
+func (s *goPackageScope) resolveCall(call *ast.CallExpr, locals map[string][]ast.Expr, depth int) (string, bool) {
+	resolveArg := func(index int) (string, bool) {
+		if index >= len(call.Args) {
+			return "", false
+		}
+		return s.resolve(call.Args[index], locals, depth+1)
+	}
+
+	switch getGoCallName(call) {
+	case "fmt.Sprintf":
+		format, ok := resolveArg(0)
+		if !ok {
+			return "", false
+		}
+		argIndex := 0
+		resolved := true
+		value := goFormatVerbRegex.ReplaceAllStringFunc(format, func(string) string {
+			argIndex++
+			arg, ok := resolveArg(argIndex)
+			resolved = resolved && ok
+			return arg
+		})
+		return value, resolved
+	case "fmt.Sprint":
+		var values []string
+		for index := range call.Args {
+			value, ok := resolveArg(index)
+			if !ok {
+				return "", false
+			}
+			values = append(values, value)
+		}
+		return strings.Join(values, ""), true
+	case "strconv.Itoa", "strconv.FormatInt", "strconv.FormatUint", "strconv.Atoi", "strconv.ParseInt", "strconv.ParseUint":
+		return resolveArg(0)
+	case "net.JoinHostPort":
+		host, _ := resolveArg(0)
+		port, ok := resolveArg(1)
+		return host + ":" + port, ok
+	case "os.Getenv", "os.LookupEnv":
+		if name, ok := resolveArg(0); ok {
+			return s.getEnvValue(name)
+		}
+	case "envy.Get":
+		// e.g. envy.Get("PORT", "3000") for Buffalo
+		if name, ok := resolveArg(0); ok {
+			if value, ok := s.getEnvValue(name); ok {
+				return value, true
+			}
+		}
+		return resolveArg(1)
+	case "cmp.Or":
+		for index := range call.Args {
+			if value, ok := resolveArg(index); ok && value != "" {
+				return value, true
+			}
+		}
+	case "flag.Int", "flag.Int64", "flag.Uint", "flag.Uint64", "flag.String":
+		return resolveArg(1)
+	case "pflag.Int", "pflag.Int64", "pflag.Uint", "pflag.Uint64", "pflag.String":
+		return resolveArg(1)
+	case "pflag.IntP", "pflag.Int64P", "pflag.UintP", "pflag.Uint64P", "pflag.StringP":
+		return resolveArg(2)
+	case "int", "int32", "int64", "uint", "uint16", "uint32", "uint64", "string":
+		return resolveArg(0)
+	default:
+		if ident, ok := call.Fun.(*ast.Ident); ok {
+			return s.resolveEnvFunc(ident.Name, call, locals, depth)
+		}
+	}
+	return "", false
+}
+
+// resolveEnvFunc resolves the calls to a function of the package reading an env var with a fallback value,
+// e.g. getEnv("PORT", "8080"). The first argument is the env var and the last one the fallback value.
+func (s *goPackageScope) resolveEnvFunc(name string, call *ast.CallExpr, locals map[string][]ast.Expr, depth int) (string, bool) {
+	funcDecl, found := s.funcs[name]
+	if !found || funcDecl.Body == nil || len(call.Args) < 2 || !isGoEnvReader(funcDecl.Body) {
+		return "", false
+	}
+	if envVar, ok := s.resolve(call.Args[0], locals, depth+1); ok {
+		if value, ok := s.getEnvValue(envVar); ok {
+			return value, true
+		}
+	}
+	return s.resolve(call.Args[len(call.Args)-1], locals, depth+1)
+}
+
+// getEnvValue returns the value of the env var, set in the system or inside the Dockerfile of the component
+func (s *goPackageScope) getEnvValue(name string) (string, bool) {
+	if value, found := os.LookupEnv(name); found && value != "" {
+		return value, true
+	}
+	envVars, err := utils.GetEnvVarsFromDockerFile(s.root)
+	if err != nil {
+		return "", false
+	}
+	for _, envVar := range envVars {
+		if envVar.Name == name && envVar.Value != "" {
+			return envVar.Value, true
+		}
+	}
+	return "", false
+}
+
+// getGoLocalValues returns the values assigned to the variables declared inside a function body, in source order
+func getGoLocalValues(body *ast.BlockStmt) map[string][]ast.Expr {
+	values := map[string][]ast.Expr{}
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.AssignStmt:
+			if len(node.Lhs) != len(node.Rhs) && len(node.Rhs) != 1 {
+				return true
+			}
+			for index, lhs := range node.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if !ok || ident.Name == "_" {
+					continue
+				}
+				if len(node.Lhs) == len(node.Rhs) {
+					values[ident.Name] = append(values[ident.Name], node.Rhs[index])
+				} else if index == 0 {
+					// e.g. port, err := strconv.Atoi(os.Getenv("PORT"))
+					values[ident.Name] = append(values[ident.Name], node.Rhs[0])
+				}
+			}
+		case *ast.DeclStmt:
+			if genDecl, ok := node.Decl.(*ast.GenDecl); ok {
+				addGoValueSpecs(values, genDecl)
+			}
+		}
+		return true
+	})
+	return values
+}
+
+// addGoValueSpecs adds the values of the constants and variables declared by decl
+func addGoValueSpecs(values map[string][]ast.Expr, decl *ast.GenDecl) {
+	if decl.Tok != token.CONST && decl.Tok != token.VAR {
+		return
+	}
+	for _, spec := range decl.Specs {
+		valueSpec, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+		for index, name := range valueSpec.Names {
+			if index < len(valueSpec.Values) {
+				values[name.Name] = append(values[name.Name], valueSpec.Values[index])
+			}
+		}
+	}
+}
+
+// addGoFlagVars adds the default values of the variables bound to a flag, e.g. flag.IntVar(&port, "port", 8080, "")
+func addGoFlagVars(values map[string][]ast.Expr, file *ast.File) {
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		name := getGoCallName(call)
+		var defaultIndex int
+		switch {
+		case strings.HasPrefix(name, "pflag.") && strings.HasSuffix(name, "VarP"):
+			defaultIndex = 3
+		case (strings.HasPrefix(name, "flag.") || strings.HasPrefix(name, "pflag.")) && strings.HasSuffix(name, "Var"):
+			defaultIndex = 2
+		default:
+			return true
+		}
+		if len(call.Args) <= defaultIndex {
+			return true
+		}
+		if unary, ok := call.Args[0].(*ast.UnaryExpr); ok && unary.Op == token.AND {
+			if ident, ok := unary.X.(*ast.Ident); ok {
+				values[ident.Name] = append(values[ident.Name], call.Args[defaultIndex])
+			}
+		}
+		return true
+	})
+}
+
+// isGoEnvReader checks if the function body reads an env var
+func isGoEnvReader(body *ast.BlockStmt) bool {
+	readsEnv := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok {
+			if name := getGoCallName(call); name == "os.Getenv" || name == "os.LookupEnv" {
+				readsEnv = true
+			}
+		}
+		return !readsEnv
+	})
+	return readsEnv
+}
+
+// getGoCallName returns the name of the called function, qualified by its receiver or package if any,
+// e.g. fmt.Sprintf, router.Run or main
+func getGoCallName(call *ast.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		if ident, ok := fun.X.(*ast.Ident); ok {
+			return fmt.Sprintf("%s.%s", ident.Name, fun.Sel.Name)
+		}
+		return fun.Sel.Name
+	}
+	return ""
+}
+
+// getPortFromGoValue returns the port of a value, which is either a port or a host:port address
+func getPortFromGoValue(value string) int {
+	value = strings.TrimSpace(value)
+	if port, err := utils.GetValidPort(value); err == nil {
+		return port
+	}
+	return utils.FindPortSubmatch(goHostPortRegex, value, 1)
+}
@@ -0,0 +1,186 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+// goMajorVersionRegex matches the major version suffix of a module path, e.g. v2 in github.com/acme/api/v2
+var goMajorVersionRegex = regexp.MustCompile(`^v\d+$`)
+
+// GoModule is a Go module found inside the source tree
+type GoModule struct {
+	Name   string
+	Path   string
+	GoMod  *modfile.File
+	Module string
+}
+
+// GetGoModuleName returns the name of a module from its path: the last element, skipping the major version suffix.
+// github.com/acme/api/v2 is named api.
+func GetGoModuleName(modulePath string) string {
+	elements := strings.Split(strings.Trim(modulePath, "/"), "/")
+	name := elements[len(elements)-1]
+	if len(elements) > 1 && goMajorVersionRegex.MatchString(name) {
+		name = elements[len(elements)-2]
+	}
+	return name
+}
+
+// GetGoModule returns the module declared by the go.mod inside dir
+func GetGoModule(dir string) (GoModule, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return GoModule{}, false
+	}
+	goModPath := filepath.Join(dir, "go.mod")
+	bytes, err := os.ReadFile(filepath.Clean(goModPath))
+	if err != nil {
+		return GoModule{}, false
+	}
+	goMod, err := modfile.Parse(goModPath, bytes, nil)
+	if err != nil || goMod.Module == nil {
+		return GoModule{}, false
+	}
+	return GoModule{
+		Name:   GetGoModuleName(goMod.Module.Mod.Path),
+		Path:   dir,
+		GoMod:  goMod,
+		Module: goMod.Module.Mod.Path,
+	}, true
+}
+
+// GetGoModuleOfDir returns the module dir belongs to, which is declared by the closest go.mod in dir or in its parents
+func GetGoModuleOfDir(dir string) (GoModule, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return GoModule{}, false
+	}
+	for {
+		if module, found := GetGoModule(dir); found {
+			return module, true
+		}
+		if filepath.Dir(dir) == dir {
+			return GoModule{}, false
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// GetGoWorkspaceRoot returns the folder of the go.work file which uses the module inside dir. As the go command does,
+// only the closest go.work file is considered.
+func GetGoWorkspaceRoot(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for root := dir; ; root = filepath.Dir(root) {
+		if workFile, err := getGoWorkFile(root); err == nil {
+			for _, use := range workFile.Use {
+				if filepath.Join(root, use.Path) == dir {
+					return root
+				}
+			}
+			return ""
+		}
+		if filepath.Dir(root) == root {
+			return ""
+		}
+	}
+}
+
+// GetGoWorkspaceModules returns the modules listed by the use directives of the go.work file inside root
+func GetGoWorkspaceModules(root string) []GoModule {
+	workFile, err := getGoWorkFile(root)
+	if err != nil {
+		return []GoModule{}
+	}
+	var modules []GoModule
+	for _, use := range workFile.Use {
+		if module, found := GetGoModule(filepath.Join(root, use.Path)); found {
+			modules = append(modules, module)
+		}
+	}
+	return modules
+}
+
+// GetGoModuleDependencies returns the names of the local modules the module depends on: the required modules of its
+// workspace and the required modules replaced by a local folder, either in its go.mod or in the go.work file
+func GetGoModuleDependencies(module GoModule) []string {
+	localModules := map[string]GoModule{}
+	replaces := getLocalGoReplaces(module.GoMod.Replace, module.Path)
+	if root := GetGoWorkspaceRoot(module.Path); root != "" {
+		for _, workspaceModule := range GetGoWorkspaceModules(root) {
+			localModules[workspaceModule.Module] = workspaceModule
+		}
+		// the replace directives of the go.work file take precedence over the ones of the go.mod
+		if workFile, err := getGoWorkFile(root); err == nil {
+			replaces = append(replaces, getLocalGoReplaces(workFile.Replace, root)...)
+		}
+	}
+	for _, replace := range replaces {
+		if replaced, found := GetGoModule(replace.New.Path); found {
+			localModules[replace.Old.Path] = replaced
+		}
+	}
+
+	var dependencies []string
+	for _, require := range module.GoMod.Require {
+		if localModule, found := localModules[require.Mod.Path]; found && localModule.Path != module.Path {
+			dependencies = appendIfMissing(dependencies, localModule.Name)
+		}
+	}
+	sort.Strings(dependencies)
+	return dependencies
+}
+
+// getGoWorkFile parses the go.work file inside dir
+func getGoWorkFile(dir string) (*modfile.WorkFile, error) {
+	goWorkPath := filepath.Join(dir, "go.work")
+	bytes, err := os.ReadFile(filepath.Clean(goWorkPath))
+	if err != nil {
+		return nil, err
+	}
+	return modfile.ParseWork(goWorkPath, bytes, nil)
+}
+
+// getLocalGoReplaces returns the replace directives pointing at a local folder, resolved from dir
+func getLocalGoReplaces(replaces []*modfile.Replace, dir string) []*modfile.Replace {
+	var localReplaces []*modfile.Replace
+	for _, replace := range replaces {
+		if !modfile.IsDirectoryPath(replace.New.Path) {
+			continue
+		}
+		localReplace := &modfile.Replace{Old: replace.Old, New: replace.New}
+		if !filepath.IsAbs(localReplace.New.Path) {
+			localReplace.New.Path = filepath.Join(dir, localReplace.New.Path)
+		}
+		localReplaces = append(localReplaces, localReplace)
+	}
+	return localReplaces
+}
+
+func appendIfMissing(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -13,10 +13,8 @@ package enricher
 
 import (
 	"context"
-	"regexp"
 
 	"github.com/devfile/alizer/pkg/apis/model"
-	"github.com/devfile/alizer/pkg/utils"
 	"golang.org/x/mod/modfile"
 )
 
@@ -27,40 +25,24 @@ func (g GoFiberDetector) GetSupportedFrameworks() []string {
 }
 
 func (g GoFiberDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
-	files, err := utils.GetCachedFilePathsFromRoot(componentPath, ctx)
-	if err != nil {
-		return []model.ApplicationFileInfo{}
-	}
-	return utils.GenerateApplicationFileFromFilters(files, componentPath, ".go", ctx)
+	return GetGoApplicationFileInfos(componentPath, ctx)
 }
 
 // DoFrameworkDetection uses a tag to check for the framework name
-func (g GoFiberDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File) {
+func (g GoFiberDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
 	if hasFramework(goMod.Require, "github.com/gofiber/fiber") {
 		language.Frameworks = append(language.Frameworks, "GoFiber")
 	}
 }
 
 func (g GoFiberDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
-	fileContents, err := utils.GetApplicationFileContents(g.GetApplicationFileInfos(component.Path, ctx))
-	if err != nil {
-		return
-	}
-
-	matchRegexRules := model.PortMatchRules{
-		MatchIndexRegexes: []model.PortMatchRule{
-			{
-				Regex:     regexp.MustCompile(`.Listen\(([^,)]*)`),
-				ToReplace: ".Listen(",
-			},
+	ports := getGoPortsFromFiles(component.Path, g.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Calls: []goPortCall{
+			{Name: "Listen"},
+			{Name: "ListenTLS"},
 		},
-	}
-
-	for _, fileContent := range fileContents {
-		ports := GetPortFromFileGo(matchRegexRules, fileContent)
-		if len(ports) > 0 {
-			component.Ports = ports
-			return
-		}
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
 	}
 }
@@ -0,0 +1,49 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"golang.org/x/mod/modfile"
+)
+
+type GrpcDetector struct{}
+
+func (g GrpcDetector) GetSupportedFrameworks() []string {
+	return []string{"gRPC"}
+}
+
+func (g GrpcDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return GetGoApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (g GrpcDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
+	if hasFramework(goMod.Require, "google.golang.org/grpc") {
+		language.Frameworks = append(language.Frameworks, "gRPC")
+	}
+}
+
+// DoPortsDetection searches for the address of the listener served by the gRPC server,
+// e.g. lis, err := net.Listen("tcp", ":50051")
+func (g GrpcDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	ports := getGoPortsFromFiles(component.Path, g.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Calls: []goPortCall{
+			{Name: "Listen", ArgIndex: 1},
+		},
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
+	}
+}
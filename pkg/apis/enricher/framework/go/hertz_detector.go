@@ -0,0 +1,49 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"golang.org/x/mod/modfile"
+)
+
+type HertzDetector struct{}
+
+func (h HertzDetector) GetSupportedFrameworks() []string {
+	return []string{"Hertz"}
+}
+
+func (h HertzDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return GetGoApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (h HertzDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
+	if hasFramework(goMod.Require, "github.com/cloudwego/hertz") {
+		language.Frameworks = append(language.Frameworks, "Hertz")
+	}
+}
+
+// DoPortsDetection searches for the address passed to the server.WithHostPorts option,
+// e.g. h := server.Default(server.WithHostPorts(":8888"))
+func (h HertzDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	ports := getGoPortsFromFiles(component.Path, h.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Calls: []goPortCall{
+			{Name: "WithHostPorts"},
+		},
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
+	}
+}
@@ -0,0 +1,50 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"golang.org/x/mod/modfile"
+)
+
+type IrisDetector struct{}
+
+func (i IrisDetector) GetSupportedFrameworks() []string {
+	return []string{"Iris"}
+}
+
+func (i IrisDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return GetGoApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (i IrisDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
+	if hasFramework(goMod.Require, "github.com/kataras/iris") {
+		language.Frameworks = append(language.Frameworks, "Iris")
+	}
+}
+
+// DoPortsDetection searches for the address passed to app.Listen or to the iris.Addr and iris.TLS runners
+func (i IrisDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	ports := getGoPortsFromFiles(component.Path, i.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Calls: []goPortCall{
+			{Name: "Listen"},
+			{Name: "Addr"},
+			{Name: "TLS"},
+		},
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
+	}
+}
@@ -0,0 +1,84 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/schema"
+	"github.com/devfile/alizer/pkg/utils"
+	"golang.org/x/mod/modfile"
+	"gopkg.in/yaml.v3"
+)
+
+type KratosDetector struct{}
+
+func (k KratosDetector) GetSupportedFrameworks() []string {
+	return []string{"Kratos"}
+}
+
+func (k KratosDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return GetGoApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (k KratosDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
+	if hasFramework(goMod.Require, "github.com/go-kratos/kratos") {
+		language.Frameworks = append(language.Frameworks, "Kratos")
+	}
+}
+
+// DoPortsDetection searches for the addresses of the servers inside configs/config.yaml and then for the address
+// passed to the http.Address and grpc.Address options of the transports
+func (k KratosDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	root := component.Path
+	if module, found := GetGoModuleOfDir(component.Path); found {
+		root = module.Path
+	}
+	if ports := getKratosPortsFromConfig(filepath.Join(root, "configs", "config.yaml")); len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+
+	ports := getGoPortsFromFiles(component.Path, k.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Calls: []goPortCall{
+			{Name: "Address"},
+		},
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
+	}
+}
+
+// getKratosPortsFromConfig returns the sorted ports of the server addresses of a Kratos config file
+func getKratosPortsFromConfig(configPath string) []int {
+	bytes, err := os.ReadFile(filepath.Clean(configPath))
+	if err != nil {
+		return []int{}
+	}
+	var config schema.KratosConfig
+	if err := yaml.Unmarshal(bytes, &config); err != nil {
+		return []int{}
+	}
+	var ports []int
+	for _, server := range config.Server {
+		if port := getPortFromGoValue(server.Addr); port != -1 {
+			ports = utils.AppendPortIfMissing(ports, port)
+		}
+	}
+	sort.Ints(ports)
+	return ports
+}
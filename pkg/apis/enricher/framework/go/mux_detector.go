@@ -13,10 +13,8 @@ package enricher
 
 import (
 	"context"
-	"regexp"
 
 	"github.com/devfile/alizer/pkg/apis/model"
-	"github.com/devfile/alizer/pkg/utils"
 	"golang.org/x/mod/modfile"
 )
 
@@ -27,46 +25,25 @@ func (m MuxDetector) GetSupportedFrameworks() []string {
 }
 
 func (m MuxDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
-	files, err := utils.GetCachedFilePathsFromRoot(componentPath, ctx)
-	if err != nil {
-		return []model.ApplicationFileInfo{}
-	}
-	return utils.GenerateApplicationFileFromFilters(files, componentPath, ".go", ctx)
+	return GetGoApplicationFileInfos(componentPath, ctx)
 }
 
 // DoFrameworkDetection uses a tag to check for the framework name
-func (m MuxDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File) {
+func (m MuxDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
 	if hasFramework(goMod.Require, "github.com/gorilla/mux") {
 		language.Frameworks = append(language.Frameworks, "Mux")
 	}
 }
 
 func (m MuxDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
-	fileContents, err := utils.GetApplicationFileContents(m.GetApplicationFileInfos(component.Path, ctx))
-	if err != nil {
-		return
-	}
-
-	matchRegexRules := model.PortMatchRules{
-		MatchIndexRegexes: []model.PortMatchRule{
-			{
-				Regex:     regexp.MustCompile(`.ListenAndServe\([^,)]*`),
-				ToReplace: ".ListenAndServe(",
-			},
+	ports := getGoPortsFromFiles(component.Path, m.GetApplicationFileInfos(component.Path, ctx), goPortRules{
+		Calls: []goPortCall{
+			{Name: "ListenAndServe"},
+			{Name: "ListenAndServeTLS"},
 		},
-		MatchRegexes: []model.PortMatchSubRule{
-			{
-				Regex:    regexp.MustCompile(`Addr:\s+"([^",]+)`),
-				SubRegex: regexp.MustCompile(`:*(\d+)$`),
-			},
-		},
-	}
-
-	for _, fileContent := range fileContents {
-		ports := GetPortFromFileGo(matchRegexRules, fileContent)
-		if len(ports) > 0 {
-			component.Ports = ports
-			return
-		}
+		Fields: []string{"Addr"},
+	})
+	if len(ports) > 0 {
+		component.Ports = ports
 	}
 }
@@ -0,0 +1,98 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"golang.org/x/mod/modfile"
+)
+
+// netHttpServerNames are the functions and types of net/http starting a server
+var netHttpServerNames = []string{"ListenAndServe", "ListenAndServeTLS", "Serve", "ServeTLS", "Server"}
+
+type NetHttpDetector struct{}
+
+func (n NetHttpDetector) GetSupportedFrameworks() []string {
+	return []string{"net/http"}
+}
+
+func (n NetHttpDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return GetGoApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection reports net/http for the modules without any other framework whose sources start
+// a net/http server. Only the files importing net/http are fully parsed.
+func (n NetHttpDetector) DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context) {
+	if len(language.Frameworks) > 0 || goMod.Syntax == nil {
+		return
+	}
+	fileSet := token.NewFileSet()
+	for _, file := range getGoModuleFiles(filepath.Dir(goMod.Syntax.Name), ctx) {
+		if filepath.Ext(file) != ".go" {
+			continue
+		}
+		importsFile, err := parser.ParseFile(fileSet, file, nil, parser.ImportsOnly)
+		if err != nil || getNetHttpPackageName(importsFile) == "" {
+			continue
+		}
+		parsedFile, err := parser.ParseFile(fileSet, file, nil, 0)
+		if err == nil && isNetHttpServerStarted(parsedFile) {
+			language.Frameworks = append(language.Frameworks, "net/http")
+			return
+		}
+	}
+}
+
+func (n NetHttpDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	DoGoPortsDetection(component, ctx)
+}
+
+// getNetHttpPackageName returns the name net/http is imported with by the file, or "" if it is not imported
+func getNetHttpPackageName(file *ast.File) string {
+	packageName := ""
+	for _, importSpec := range file.Imports {
+		if importPath, err := strconv.Unquote(importSpec.Path.Value); err == nil && importPath == "net/http" {
+			packageName = "http"
+			if importSpec.Name != nil {
+				packageName = importSpec.Name.Name
+			}
+		}
+	}
+	return packageName
+}
+
+// isNetHttpServerStarted checks if the file imports net/http and uses it to start a server
+func isNetHttpServerStarted(file *ast.File) bool {
+	packageName := getNetHttpPackageName(file)
+	if packageName == "" {
+		return false
+	}
+	started := false
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok && ident.Name == packageName {
+				for _, name := range netHttpServerNames {
+					started = started || selector.Sel.Name == name
+				}
+			}
+		}
+		return !started
+	})
+	return started
+}
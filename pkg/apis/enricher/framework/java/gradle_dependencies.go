@@ -0,0 +1,230 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/schema"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+var (
+	// gradleStringNotationRegex matches the dependencies declared as "group:name:version" strings
+	gradleStringNotationRegex = regexp.MustCompile(`["']([\w.\-${}]+):([\w.\-${}]+)(?::[^"'\s]*)?["']`)
+	// gradleMapNotationRegex matches the dependencies declared with a group and a name, e.g. group: 'io.quarkus', name: 'quarkus-core'
+	gradleMapNotationRegex = regexp.MustCompile(`group\s*[:=]\s*["']([^"']+)["']\s*,\s*name\s*[:=]\s*["']([^"']+)["']`)
+	// gradlePluginIdRegex matches the plugins applied by id, e.g. id 'io.quarkus' or apply plugin: 'war'
+	gradlePluginIdRegex = regexp.MustCompile(`(?:\bid\s*\(?\s*|apply\s*\(?\s*plugin\s*[:=]\s*)["']([\w.\-]+)["']`)
+	// gradleKotlinRegex matches the kotlin() shortcut of the Kotlin DSL, e.g. kotlin("jvm") or kotlin("stdlib")
+	gradleKotlinRegex = regexp.MustCompile(`\bkotlin\s*\(\s*"([\w.\-]+)"`)
+	// gradleCatalogAccessorRegex matches the accessors of the libs version catalog, e.g. libs.spring.boot.starter.web
+	gradleCatalogAccessorRegex = regexp.MustCompile(`\blibs\.([\w.]+)`)
+	// gradlePropertyRegex matches the references to a property inside a string, e.g. $quarkusVersion or ${quarkusVersion}
+	gradlePropertyRegex = regexp.MustCompile(`\$\{?(\w+)\}?`)
+)
+
+// GradleArtifact is a dependency or a plugin declared inside a Gradle build script. Plugins only have a GroupId,
+// which is their id.
+type GradleArtifact struct {
+	GroupId    string
+	ArtifactId string
+}
+
+// GetGradleArtifacts returns the dependencies and the plugins declared inside a build.gradle or build.gradle.kts file.
+// It handles the string ("group:name:version") and map notations, the plugin ids, the kotlin() shortcut and the
+// accessors of the libs version catalog (gradle/libs.versions.toml), for libraries, bundles and plugin aliases.
+// References to the properties of gradle.properties are resolved.
+func GetGradleArtifacts(buildFile string) []GradleArtifact {
+	bytes, err := os.ReadFile(filepath.Clean(buildFile))
+	if err != nil {
+		return []GradleArtifact{}
+	}
+	content := string(bytes)
+	properties := getGradleProperties(filepath.Dir(buildFile))
+
+	var artifacts []GradleArtifact
+	for _, match := range gradleStringNotationRegex.FindAllStringSubmatch(content, -1) {
+		artifacts = append(artifacts, GradleArtifact{
+			GroupId:    interpolateGradleValue(match[1], properties),
+			ArtifactId: interpolateGradleValue(match[2], properties),
+		})
+	}
+	for _, match := range gradleMapNotationRegex.FindAllStringSubmatch(content, -1) {
+		artifacts = append(artifacts, GradleArtifact{
+			GroupId:    interpolateGradleValue(match[1], properties),
+			ArtifactId: interpolateGradleValue(match[2], properties),
+		})
+	}
+	for _, match := range gradlePluginIdRegex.FindAllStringSubmatch(content, -1) {
+		artifacts = append(artifacts, GradleArtifact{GroupId: match[1]})
+	}
+	for _, match := range gradleKotlinRegex.FindAllStringSubmatch(content, -1) {
+		artifacts = append(artifacts, GradleArtifact{GroupId: "org.jetbrains.kotlin", ArtifactId: "kotlin-" + match[1]})
+	}
+	if versionCatalog, found := getGradleVersionCatalog(filepath.Dir(buildFile)); found {
+		for _, match := range gradleCatalogAccessorRegex.FindAllStringSubmatch(content, -1) {
+			artifacts = append(artifacts, resolveGradleCatalogAccessor(versionCatalog, match[1])...)
+		}
+	}
+	return artifacts
+}
+
+// IsArtifactInGradleBuild checks if a dependency or a plugin of the build file matches groupId and artifactId
+func IsArtifactInGradleBuild(buildFile string, groupId string, artifactId string) bool {
+	for _, artifact := range GetGradleArtifacts(buildFile) {
+		if strings.Contains(artifact.GroupId, groupId) && strings.Contains(artifact.ArtifactId, artifactId) {
+			return true
+		}
+	}
+	return false
+}
+
+// getGradleProperties returns the properties of the gradle.properties files of dir and of its parent folders,
+// the closest ones taking precedence
+func getGradleProperties(dir string) map[string]string {
+	properties := map[string]string{}
+	for {
+		file, err := os.Open(filepath.Join(dir, "gradle.properties"))
+		if err == nil {
+			scanner := bufio.NewScanner(file)
+			for scanner.Scan() {
+				line := strings.TrimSpace(scanner.Text())
+				if strings.HasPrefix(line, "#") {
+					continue
+				}
+				if key, value, found := strings.Cut(line, "="); found {
+					if _, exists := properties[strings.TrimSpace(key)]; !exists {
+						properties[strings.TrimSpace(key)] = strings.TrimSpace(value)
+					}
+				}
+			}
+			utils.CloseFile(file)
+		}
+		if isGradleRootProject(dir) || filepath.Dir(dir) == dir {
+			return properties
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// interpolateGradleValue replaces the references to properties inside value
+func interpolateGradleValue(value string, properties map[string]string) string {
+	return gradlePropertyRegex.ReplaceAllStringFunc(value, func(reference string) string {
+		name := strings.Trim(reference, "${}")
+		if property, found := properties[name]; found {
+			return property
+		}
+		return reference
+	})
+}
+
+// getGradleVersionCatalog returns the libs version catalog (gradle/libs.versions.toml) of the build inside dir,
+// searching from dir up to the root project
+func getGradleVersionCatalog(dir string) (schema.GradleVersionCatalog, bool) {
+	for {
+		if versionCatalog, err := utils.GetGradleVersionCatalogSchemaFromFile(filepath.Join(dir, "gradle", "libs.versions.toml")); err == nil {
+			return versionCatalog, true
+		}
+		if isGradleRootProject(dir) || filepath.Dir(dir) == dir {
+			return schema.GradleVersionCatalog{}, false
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// isGradleRootProject checks if dir holds the settings file of a Gradle build
+func isGradleRootProject(dir string) bool {
+	for _, settingsFile := range []string{"settings.gradle", "settings.gradle.kts"} {
+		if _, err := os.Stat(filepath.Join(dir, settingsFile)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeGradleCatalogAlias returns the alias as used by its accessor: dashes and underscores become dots
+func normalizeGradleCatalogAlias(alias string) string {
+	return strings.ToLower(strings.NewReplacer("-", ".", "_", ".").Replace(alias))
+}
+
+// resolveGradleCatalogAccessor returns the artifacts referenced by the accessor of the version catalog,
+// e.g. spring.boot.starter.web, bundles.spring or plugins.spring.boot
+func resolveGradleCatalogAccessor(versionCatalog schema.GradleVersionCatalog, accessor string) []GradleArtifact {
+	accessor = strings.TrimSuffix(strings.TrimSuffix(accessor, ".get"), ".asProvider")
+	accessor = normalizeGradleCatalogAlias(accessor)
+
+	var artifacts []GradleArtifact
+	switch {
+	case strings.HasPrefix(accessor, "versions."):
+		return artifacts
+	case strings.HasPrefix(accessor, "plugins."):
+		for alias, plugin := range versionCatalog.Plugins {
+			if normalizeGradleCatalogAlias(alias) == strings.TrimPrefix(accessor, "plugins.") {
+				artifacts = append(artifacts, getGradleCatalogPlugin(plugin))
+			}
+		}
+	case strings.HasPrefix(accessor, "bundles."):
+		for alias, libraries := range versionCatalog.Bundles {
+			if normalizeGradleCatalogAlias(alias) != strings.TrimPrefix(accessor, "bundles.") {
+				continue
+			}
+			for _, library := range libraries {
+				artifacts = append(artifacts, resolveGradleCatalogAccessor(versionCatalog, library)...)
+			}
+		}
+	default:
+		for alias, library := range versionCatalog.Libraries {
+			if normalizeGradleCatalogAlias(alias) == accessor {
+				artifacts = append(artifacts, getGradleCatalogLibrary(library))
+			}
+		}
+	}
+	return artifacts
+}
+
+// getGradleCatalogLibrary returns the library declared as "group:name:version" or as a table with a module or
+// a group and a name
+func getGradleCatalogLibrary(library interface{}) GradleArtifact {
+	var module string
+	switch value := library.(type) {
+	case string:
+		module = value
+	case map[string]interface{}:
+		if coordinates, ok := value["module"].(string); ok {
+			module = coordinates
+		} else {
+			group, _ := value["group"].(string)
+			name, _ := value["name"].(string)
+			module = group + ":" + name
+		}
+	}
+	groupId, artifactId, _ := strings.Cut(module, ":")
+	artifactId, _, _ = strings.Cut(artifactId, ":")
+	return GradleArtifact{GroupId: groupId, ArtifactId: artifactId}
+}
+
+// getGradleCatalogPlugin returns the plugin declared as "id:version" or as a table with an id
+func getGradleCatalogPlugin(plugin interface{}) GradleArtifact {
+	var id string
+	switch value := plugin.(type) {
+	case string:
+		id, _, _ = strings.Cut(value, ":")
+	case map[string]interface{}:
+		id, _ = value["id"].(string)
+	}
+	return GradleArtifact{GroupId: id}
+}
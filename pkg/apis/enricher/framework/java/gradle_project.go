@@ -0,0 +1,139 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+var (
+	// gradleIncludeRegex matches the include statements of a settings file, e.g. include(":app", ":lib") or include 'app'
+	gradleIncludeRegex = regexp.MustCompile(`(?m)^\s*include\s*\(?((?:\s*["'][^"']+["']\s*,?)+)\)?`)
+	// gradleQuotedRegex matches a single or double-quoted string
+	gradleQuotedRegex = regexp.MustCompile(`["']([^"']+)["']`)
+	// gradleApplicationPluginRegex matches the plugins producing an application which can be deployed on its own
+	gradleApplicationPluginRegex = regexp.MustCompile("(?m)(?:\\bid\\s*\\(?\\s*[\"'](?:application|org\\.springframework\\.boot|io\\.quarkus|war)[\"']|apply\\s+plugin\\s*:\\s*[\"'](?:application|org\\.springframework\\.boot|io\\.quarkus|war)[\"']|^\\s*`?(?:application|war)`?\\s*$)")
+)
+
+// GradleSubproject is a project included by the settings file of a Gradle multi-project build
+type GradleSubproject struct {
+	Name string
+	Path string
+}
+
+// GetGradleSubprojects returns the subprojects included inside the settings.gradle (or settings.gradle.kts) of root.
+// A project path like :services:api is located in the services/api folder and is named api.
+func GetGradleSubprojects(root string) []GradleSubproject {
+	var subprojects []GradleSubproject
+	for _, settingsFile := range []string{"settings.gradle", "settings.gradle.kts"} {
+		bytes, err := os.ReadFile(filepath.Join(root, settingsFile))
+		if err != nil {
+			continue
+		}
+		for _, include := range gradleIncludeRegex.FindAllStringSubmatch(string(bytes), -1) {
+			for _, projectPath := range gradleQuotedRegex.FindAllStringSubmatch(include[1], -1) {
+				segments := strings.Split(strings.Trim(projectPath[1], ":"), ":")
+				subprojects = append(subprojects, GradleSubproject{
+					Name: segments[len(segments)-1],
+					Path: filepath.Join(append([]string{root}, segments...)...),
+				})
+			}
+		}
+	}
+	return subprojects
+}
+
+// GetGradleSubproject returns the subproject inside dir if it belongs to a Gradle multi-project build. As Gradle does,
+// only the closest settings file above dir is considered and the lookup never goes above the root of the repository
+// (the first folder under version control). The subprojects of a settings file are read once per analysis and cached
+// inside ctx, so the other subprojects of the same build reuse them.
+func GetGradleSubproject(dir string, ctx *context.Context) (GradleSubproject, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return GradleSubproject{}, false
+	}
+	root := getGradleSettingsRoot(dir)
+	if root == "" {
+		return GradleSubproject{}, false
+	}
+	for _, subproject := range getCachedGradleSubprojects(root, ctx) {
+		if subproject.Path == dir {
+			return subproject, true
+		}
+	}
+	return GradleSubproject{}, false
+}
+
+// getGradleSettingsRoot returns the closest parent folder of dir with a settings file, or an empty string if
+// there is none up to the root of the repository
+func getGradleSettingsRoot(dir string) string {
+	for !utils.IsVCSRoot(dir) && filepath.Dir(dir) != dir {
+		dir = filepath.Dir(dir)
+		if isGradleSettingsInDir(dir) {
+			return dir
+		}
+	}
+	return ""
+}
+
+func isGradleSettingsInDir(dir string) bool {
+	for _, settingsFile := range []string{"settings.gradle", "settings.gradle.kts"} {
+		if _, err := os.Stat(filepath.Join(dir, settingsFile)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func getCachedGradleSubprojects(root string, ctx *context.Context) []GradleSubproject {
+	subprojectsFromRoot := getGradleSubprojectsFromContext(*ctx)
+	if subprojects, cached := subprojectsFromRoot[root]; cached {
+		return subprojects
+	}
+	subprojects := GetGradleSubprojects(root)
+	subprojectsFromRoot[root] = subprojects
+	*ctx = context.WithValue(*ctx, key("mapGradleSubprojectsFromRoot"), subprojectsFromRoot)
+	return subprojects
+}
+
+func getGradleSubprojectsFromContext(ctx context.Context) map[string][]GradleSubproject {
+	subprojectsFromRoot := ctx.Value(key("mapGradleSubprojectsFromRoot"))
+	if subprojectsFromRoot != nil {
+		return subprojectsFromRoot.(map[string][]GradleSubproject)
+	}
+	return make(map[string][]GradleSubproject)
+}
+
+// IsGradleMultiProjectRoot checks if the settings file inside dir includes subprojects
+func IsGradleMultiProjectRoot(dir string, ctx *context.Context) bool {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	return len(getCachedGradleSubprojects(dir, ctx)) > 0
+}
+
+// IsGradleApplicationProject checks if the build file applies a plugin producing an application: application,
+// org.springframework.boot, io.quarkus or war
+func IsGradleApplicationProject(buildFile string) bool {
+	bytes, err := os.ReadFile(filepath.Clean(buildFile))
+	if err != nil {
+		return false
+	}
+	return gradleApplicationPluginRegex.Match(bytes)
+}
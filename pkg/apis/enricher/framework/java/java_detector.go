@@ -24,10 +24,10 @@ type ApplicationPropertiesFile struct {
 	File string
 }
 
-// hasFramework uses the build.gradle, groupId, and artifactId to check for framework
+// hasFramework uses the build.gradle (or build.gradle.kts), groupId, and artifactId to check for framework
 func hasFramework(configFile, groupId, artifactId string) (bool, error) {
-	if utils.IsPathOfWantedFile(configFile, "build.gradle") {
-		return utils.IsTagInFile(configFile, groupId)
+	if utils.IsPathOfWantedFile(configFile, "build.gradle") || utils.IsPathOfWantedFile(configFile, "build.gradle.kts") {
+		return IsArtifactInGradleBuild(configFile, groupId, artifactId), nil
 	} else if artifactId != "" {
 		return utils.IsTagInPomXMLFileArtifactId(configFile, groupId, artifactId)
 	} else {
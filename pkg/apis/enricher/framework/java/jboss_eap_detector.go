@@ -13,10 +13,9 @@ package enricher
 
 import (
 	"context"
-	"encoding/xml"
+	"path/filepath"
 
 	"github.com/devfile/alizer/pkg/apis/model"
-	"github.com/devfile/alizer/pkg/schema"
 	"github.com/devfile/alizer/pkg/utils"
 )
 
@@ -68,13 +67,7 @@ func (o JBossEAPDetector) DoPortsDetection(component *model.Component, ctx *cont
 	}
 
 	for _, appFileInfo := range appFileInfos {
-		fileBytes, err := utils.GetApplicationFileBytes(appFileInfo)
-		if err != nil {
-			continue
-		}
-
-		var pom schema.Pom
-		err = xml.Unmarshal(fileBytes, &pom)
+		pom, err := utils.GetEffectivePom(filepath.Join(appFileInfo.Root, appFileInfo.Dir, appFileInfo.File))
 		if err != nil {
 			continue
 		}
@@ -0,0 +1,227 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// e.g. port = 8080, port: 8080 or ktor.deployment.port = ${?PORT}
+	hoconKeyValueRegex = regexp.MustCompile(`^([\w.\-"]+)\s*[=:]\s*(.+)$`)
+	// e.g. ${PORT} or ${?PORT}
+	hoconEnvVarRegex = regexp.MustCompile(`^\s*\$\{\??(\w+)\}`)
+	// e.g. # comment or // comment, outside of a quoted string
+	hoconCommentRegex = regexp.MustCompile(`(?:^|\s)(?:#|//)`)
+)
+
+type KtorDetector struct{}
+
+func (k KtorDetector) GetSupportedFrameworks() []string {
+	return []string{"Ktor"}
+}
+
+func (k KtorDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return []model.ApplicationFileInfo{
+		{
+			Context: ctx,
+			Root:    componentPath,
+			Dir:     filepath.FromSlash("src/main/resources"),
+			File:    "application.conf",
+		},
+		{
+			Context: ctx,
+			Root:    componentPath,
+			Dir:     filepath.FromSlash("src/main/resources"),
+			File:    "application.yaml",
+		},
+		{
+			Context: ctx,
+			Root:    componentPath,
+			Dir:     filepath.FromSlash("src/main/resources"),
+			File:    "application.yml",
+		},
+	}
+}
+
+// DoFrameworkDetection uses the groupId to check for the framework name
+func (k KtorDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFwk, _ := hasFramework(config, "io.ktor", ""); hasFwk {
+		language.Frameworks = append(language.Frameworks, "Ktor")
+	}
+}
+
+// DoPortsDetection searches for the port in src/main/resources/application.conf, src/main/resources/application.yaml
+// and for the port passed to embeddedServer inside the .kt files
+func (k KtorDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	for _, appFileInfo := range k.GetApplicationFileInfos(component.Path, ctx) {
+		fileBytes, err := utils.GetApplicationFileBytes(appFileInfo)
+		if err != nil {
+			continue
+		}
+		var port int
+		if appFileInfo.File == "application.conf" {
+			port = getKtorPortFromHocon(string(fileBytes))
+		} else {
+			port = getKtorPortFromYaml(fileBytes)
+		}
+		if port != -1 {
+			component.Ports = []int{port}
+			return
+		}
+	}
+
+	files, err := utils.GetCachedFilePathsFromRoot(component.Path, ctx)
+	if err != nil {
+		return
+	}
+	fileContents, err := utils.GetApplicationFileContents(utils.GenerateApplicationFileFromFilters(files, component.Path, ".kt", ctx))
+	if err != nil {
+		return
+	}
+	for _, fileContent := range fileContents {
+		if port := getKtorPortFromEmbeddedServer(fileContent); port != -1 {
+			component.Ports = []int{port}
+			return
+		}
+	}
+}
+
+// getKtorPortFromHocon returns the ktor.deployment.port of an application.conf file, set either inside the
+// ktor { deployment { ... } } objects or with a dotted key (e.g. ktor.deployment.port = 8080). The port keys of
+// the other objects (e.g. database { port = 5432 }) are ignored. If the port can be overridden by an env var
+// (e.g. port = ${?PORT}) its value is used when set.
+func getKtorPortFromHocon(content string) int {
+	port, envVar := -1, ""
+	var objects []string
+	handleStatement := func(statement string) {
+		matches := hoconKeyValueRegex.FindStringSubmatch(strings.TrimSpace(statement))
+		if len(matches) < 3 || strings.Join(append(objects, strings.ReplaceAll(matches[1], `"`, "")), ".") != "ktor.deployment.port" {
+			return
+		}
+		if envMatches := hoconEnvVarRegex.FindStringSubmatch(matches[2]); len(envMatches) > 1 {
+			envVar = envMatches[1]
+		} else if value, err := utils.GetValidPort(strings.Trim(strings.TrimSpace(matches[2]), `"`)); err == nil {
+			port = value
+		}
+	}
+	for _, line := range strings.Split(content, "\n") {
+		if index := hoconCommentRegex.FindStringIndex(line); index != nil {
+			line = line[:index[0]]
+		}
+		statement := ""
+		inQuotes, inSubstitution := false, false
+		for _, char := range line {
+			switch {
+			case inQuotes:
+				inQuotes = char != '"'
+			case inSubstitution:
+				inSubstitution = char != '}'
+			case char == '"':
+				inQuotes = true
+			case char == '{' && strings.HasSuffix(statement, "$"):
+				inSubstitution = true
+			case char == '{':
+				key := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(statement), "=:"))
+				objects = append(objects, strings.ReplaceAll(key, `"`, ""))
+				statement = ""
+				continue
+			case char == '}':
+				handleStatement(statement)
+				if len(objects) > 0 {
+					objects = objects[:len(objects)-1]
+				}
+				statement = ""
+				continue
+			case char == ',':
+				handleStatement(statement)
+				statement = ""
+				continue
+			}
+			statement += string(char)
+		}
+		handleStatement(statement)
+	}
+
+	if envVar != "" {
+		if ports := utils.GetValidPortsFromEnvs([]string{envVar}); len(ports) > 0 {
+			return ports[0]
+		}
+	}
+	return port
+}
+
+// getKtorPortFromYaml returns the ktor.deployment.port of an application.yaml file.
+// The port can be a number or a "$ENV_VAR:default" string.
+func getKtorPortFromYaml(bytes []byte) int {
+	var data model.KtorApplicationYaml
+	if err := yaml.Unmarshal(bytes, &data); err != nil {
+		return -1
+	}
+	switch port := data.Ktor.Deployment.Port.(type) {
+	case int:
+		if utils.IsValidPort(port) {
+			return port
+		}
+	case string:
+		matches := regexp.MustCompile(`^\$(\w+):(\d+)$`).FindStringSubmatch(port)
+		if len(matches) > 2 {
+			if ports := utils.GetValidPortsFromEnvs([]string{matches[1]}); len(ports) > 0 {
+				return ports[0]
+			}
+			port = matches[2]
+		}
+		if validPort, err := utils.GetValidPort(port); err == nil {
+			return validPort
+		}
+	}
+	return -1
+}
+
+// getKtorPortFromEmbeddedServer returns the port passed to embeddedServer, e.g. embeddedServer(Netty, port = 8080).
+// If the port is a variable, it tries to find its value within the code.
+func getKtorPortFromEmbeddedServer(content string) int {
+	re := regexp.MustCompile(`embeddedServer\(\s*\w+\s*,\s*(?:port\s*=\s*)?([\w.]+)`)
+	matchIndexes := re.FindStringSubmatchIndex(content)
+	if len(matchIndexes) < 4 {
+		return -1
+	}
+	portPlaceholder := content[matchIndexes[2]:matchIndexes[3]]
+	if port, err := utils.GetValidPort(portPlaceholder); err == nil {
+		return port
+	}
+
+	// e.g. val port = System.getenv("PORT")?.toInt() ?: 8080
+	contentBeforeMatch := content[0:matchIndexes[0]]
+	variableRegex, err := regexp.Compile(`va[lr]\s+` + regexp.QuoteMeta(portPlaceholder) + `\s*(?::\s*\w+\s*)?=\s*([^\n]+)`)
+	if err != nil {
+		return -1
+	}
+	matches := variableRegex.FindAllStringSubmatch(contentBeforeMatch, -1)
+	if len(matches) == 0 {
+		return -1
+	}
+	value := matches[len(matches)-1][1]
+	if envVar := utils.FindPotentialPortGroup(regexp.MustCompile(`getenv\(\s*"(\w+)"\s*\)`), value, 1); envVar != "" {
+		if ports := utils.GetValidPortsFromEnvs([]string{envVar}); len(ports) > 0 {
+			return ports[0]
+		}
+	}
+	return utils.FindPortSubmatch(regexp.MustCompile(`(?:^|\?:\s*)(\d+)`), value, 1)
+}
@@ -0,0 +1,273 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/schema"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+// javaMainMethodRegex matches the main method of a Java or Kotlin class
+var javaMainMethodRegex = regexp.MustCompile(`static\s+void\s+main\s*\(|\bfun\s+main\s*\(`)
+
+type key string
+
+// MavenModule is a module of a Maven multi-module (reactor) build. Its pom is the effective pom, inheriting
+// groupId, properties and dependency management from the local parents.
+type MavenModule struct {
+	Path       string
+	GroupId    string
+	ArtifactId string
+	Packaging  string
+	Pom        schema.Pom
+	Parent     *MavenModule
+	Modules    []*MavenModule
+}
+
+// GetMavenModuleNames returns the modules declared inside the pom, including the ones of its profiles
+func GetMavenModuleNames(pom schema.Pom) []string {
+	var modules []string
+	for _, module := range pom.Modules.Module {
+		if !utils.Contains(modules, module) {
+			modules = append(modules, module)
+		}
+	}
+	for _, profile := range pom.Profiles.Profile {
+		for _, module := range profile.Modules.Module {
+			if !utils.Contains(modules, module) {
+				modules = append(modules, module)
+			}
+		}
+	}
+	return modules
+}
+
+// GetMavenReactorModule returns the module inside dir of the reactor build it belongs to.
+// It returns false if dir is not part of a multi-module build. Reactors are built once per analysis
+// and cached inside ctx, so the other modules of the same build reuse them.
+func GetMavenReactorModule(dir string, ctx *context.Context) (*MavenModule, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, false
+	}
+	reactors := getMavenReactorsFromContext(*ctx)
+	for root, reactor := range reactors {
+		if reactor == nil || !isFirstPathParentOfSecond(root, dir) {
+			continue
+		}
+		if module := findMavenModule(reactor, dir); module != nil {
+			return module, root != dir
+		}
+	}
+
+	root := getMavenReactorRoot(dir)
+	if root == dir {
+		return nil, false
+	}
+	reactor, cached := reactors[root]
+	if !cached {
+		reactor = buildMavenModule(root, nil, map[string]bool{})
+		reactors[root] = reactor
+		*ctx = context.WithValue(*ctx, key("mapMavenReactorsFromRoot"), reactors)
+	}
+	if reactor == nil {
+		return nil, false
+	}
+	module := findMavenModule(reactor, dir)
+	return module, module != nil
+}
+
+func getMavenReactorsFromContext(ctx context.Context) map[string]*MavenModule {
+	reactors := ctx.Value(key("mapMavenReactorsFromRoot"))
+	if reactors != nil {
+		return reactors.(map[string]*MavenModule)
+	}
+	return make(map[string]*MavenModule)
+}
+
+// getMavenReactorRoot walks up from dir while the pom of the parent folder declares the current folder as module.
+// The walk never goes above the root of the repository (the first folder under version control).
+func getMavenReactorRoot(dir string) string {
+	for {
+		parentDir := filepath.Dir(dir)
+		if parentDir == dir || utils.IsVCSRoot(dir) || !isMavenModuleOf(parentDir, dir) {
+			return dir
+		}
+		dir = parentDir
+	}
+}
+
+// isFirstPathParentOfSecond checks if the first path is the second path or one of its parents
+func isFirstPathParentOfSecond(firstPath string, secondPath string) bool {
+	relativePath, err := filepath.Rel(firstPath, secondPath)
+	return err == nil && relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator))
+}
+
+// isMavenModuleOf checks if the pom inside parentDir declares dir as one of its modules
+func isMavenModuleOf(parentDir string, dir string) bool {
+	pom, err := utils.GetPomFileContent(filepath.Join(parentDir, "pom.xml"))
+	if err != nil {
+		return false
+	}
+	for _, module := range GetMavenModuleNames(pom) {
+		if getMavenModuleDir(parentDir, module) == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// getMavenModuleDir returns the folder of a module, which can also be declared as the path of its pom
+func getMavenModuleDir(parentDir string, module string) string {
+	moduleDir := filepath.Join(parentDir, strings.TrimSpace(module))
+	if strings.HasSuffix(moduleDir, ".xml") {
+		moduleDir = filepath.Dir(moduleDir)
+	}
+	return moduleDir
+}
+
+// buildMavenModule reads the pom inside dir and builds the tree of its modules
+func buildMavenModule(dir string, parent *MavenModule, visited map[string]bool) *MavenModule {
+	if visited[dir] {
+		return nil
+	}
+	visited[dir] = true
+	pom, err := utils.GetEffectivePom(filepath.Join(dir, "pom.xml"))
+	if err != nil {
+		return nil
+	}
+
+	module := &MavenModule{
+		Path:       dir,
+		GroupId:    pom.GroupId,
+		ArtifactId: pom.ArtifactId,
+		Packaging:  pom.Packaging,
+		Pom:        pom,
+		Parent:     parent,
+	}
+	if module.Packaging == "" {
+		module.Packaging = "jar"
+	}
+
+	for _, name := range GetMavenModuleNames(pom) {
+		if child := buildMavenModule(getMavenModuleDir(dir, name), module, visited); child != nil {
+			module.Modules = append(module.Modules, child)
+		}
+	}
+	return module
+}
+
+// findMavenModule returns the module inside dir from the tree of module
+func findMavenModule(module *MavenModule, dir string) *MavenModule {
+	if module.Path == dir {
+		return module
+	}
+	for _, child := range module.Modules {
+		if found := findMavenModule(child, dir); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// getMavenReactorRootModule returns the top module of the tree module belongs to
+func getMavenReactorRootModule(module *MavenModule) *MavenModule {
+	for module.Parent != nil {
+		module = module.Parent
+	}
+	return module
+}
+
+// IsMavenModuleDeployable checks if the module produces an artifact which can be deployed on its own: a war,
+// an ear or a jar with a main class or built by a plugin packaging applications (e.g. spring-boot-maven-plugin)
+func IsMavenModuleDeployable(module *MavenModule) bool {
+	switch module.Packaging {
+	case "war", "ear":
+		return true
+	case "pom":
+		return false
+	}
+	applicationPlugins := []string{
+		"spring-boot-maven-plugin",
+		"quarkus-maven-plugin",
+		"micronaut-maven-plugin",
+		"liberty-maven-plugin",
+		"vertx-maven-plugin",
+		"wildfly-jar-maven-plugin",
+		"exec-maven-plugin",
+	}
+	for _, plugin := range module.Pom.Build.Plugins.Plugin {
+		if utils.Contains(applicationPlugins, plugin.ArtifactId) {
+			return true
+		}
+	}
+	for _, property := range module.Pom.Properties.Property {
+		if property.XMLName.Local == "start-class" {
+			return true
+		}
+	}
+	if hasMainClass, _ := utils.IsTagInFile(filepath.Join(module.Path, "pom.xml"), "<mainClass>"); hasMainClass {
+		return true
+	}
+	return hasJavaMainMethod(filepath.Join(module.Path, "src", "main"))
+}
+
+// hasJavaMainMethod checks if a Java or Kotlin file under dir declares a main method
+func hasJavaMainMethod(dir string) bool {
+	found := false
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || found {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || (filepath.Ext(path) != ".java" && filepath.Ext(path) != ".kt") {
+			return nil
+		}
+		bytes, err := os.ReadFile(filepath.Clean(path))
+		if err == nil && javaMainMethodRegex.Match(bytes) {
+			found = true
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	return found
+}
+
+// GetMavenModuleDependencies returns the artifactIds of the other modules of the reactor the module depends on
+func GetMavenModuleDependencies(module *MavenModule) []string {
+	reactorModules := map[string]string{}
+	var collect func(*MavenModule)
+	collect = func(m *MavenModule) {
+		if m != module {
+			reactorModules[m.GroupId+":"+m.ArtifactId] = m.ArtifactId
+		}
+		for _, child := range m.Modules {
+			collect(child)
+		}
+	}
+	collect(getMavenReactorRootModule(module))
+
+	var dependencies []string
+	for _, dependency := range module.Pom.Dependencies.Dependency {
+		key := dependency.GroupId + ":" + dependency.ArtifactId
+		if artifactId, found := reactorModules[key]; found && !utils.Contains(dependencies, artifactId) {
+			dependencies = append(dependencies, artifactId)
+		}
+	}
+	return dependencies
+}
@@ -0,0 +1,44 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type AdonisJsDetector struct{}
+
+func (a AdonisJsDetector) GetSupportedFrameworks() []string {
+	return []string{"AdonisJS"}
+}
+
+func (a AdonisJsDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	// AdonisJS enricher does not apply source code detection.
+	// The port is always read from the PORT env var
+	return []model.ApplicationFileInfo{}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (a AdonisJsDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "@adonisjs/core") {
+		language.Frameworks = append(language.Frameworks, "AdonisJS")
+	}
+}
+
+// DoPortsDetection searches for the PORT env var, either set in the system, inside the Dockerfile or inside the dotenv files
+func (a AdonisJsDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	if port := resolveEnvPort("PORT", component.Path); port != -1 {
+		component.Ports = []int{port}
+	}
+}
@@ -0,0 +1,48 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type AstroDetector struct{}
+
+func (a AstroDetector) GetSupportedFrameworks() []string {
+	return []string{"Astro"}
+}
+
+func (a AstroDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	// Astro enricher does not apply source code detection.
+	// It only detects ports from the start/dev scripts and the config file
+	return []model.ApplicationFileInfo{}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (a AstroDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasDependency(config, "astro") {
+		language.Frameworks = append(language.Frameworks, "Astro")
+	}
+}
+
+// DoPortsDetection searches for the port in package.json and astro.config.*
+func (a AstroDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	configFilenames := []string{"astro.config.mjs", "astro.config.js", "astro.config.ts", "astro.config.mts", "astro.config.cjs"}
+	doFrontendPortsDetection(component, configFilenames)
+}
+
+// GetDefaultPort returns the Astro default port, used when no detector of the component found a port
+func (a AstroDetector) GetDefaultPort(componentPath string) int {
+	return 4321
+}
@@ -28,11 +28,7 @@ func (e ExpressDetector) GetSupportedFrameworks() []string {
 }
 
 func (e ExpressDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
-	files, err := utils.GetCachedFilePathsFromRoot(componentPath, ctx)
-	if err != nil {
-		return []model.ApplicationFileInfo{}
-	}
-	return utils.GenerateApplicationFileFromFilters(files, componentPath, ".js", ctx)
+	return getNodeApplicationFileInfos(componentPath, ctx)
 }
 
 // DoFrameworkDetection uses a tag to check for the framework name
@@ -67,11 +63,12 @@ func (e ExpressDetector) DoPortsDetection(component *model.Component, ctx *conte
 
 func getPortGroup(content string, matchIndexes []int, portPlaceholder string) string {
 	contentBeforeMatch := content[0:matchIndexes[0]]
-	re, err := regexp.Compile(`(let|const|var)\s+` + portPlaceholder + `\s*=\s*([^;]*)`)
+	// the type annotation is optional, e.g. const port: number = 3000
+	re, err := regexp.Compile(`(let|const|var)\s+` + regexp.QuoteMeta(portPlaceholder) + `(\s*:\s*[\w.<>| ]+?)?\s*=\s*([^;]*)`)
 	if err != nil {
 		return ""
 	}
-	return utils.FindPotentialPortGroup(re, contentBeforeMatch, 2)
+	return utils.FindPotentialPortGroup(re, contentBeforeMatch, 3)
 }
 
 func GetEnvPort(envPlaceholder string) int {
@@ -108,16 +105,52 @@ func GetEnvPortFromDockerfile(envPlaceholder string, path string) int {
 	return -1
 }
 
+// GetEnvPortFromDotEnvFiles returns a port value from the dotenv files of path matching the specified 'envPlaceholder'.
+// The files are read with the precedence given by getDotEnvFilenames. It returns -1 if no valid port is found.
+func GetEnvPortFromDotEnvFiles(envPlaceholder string, path string) int {
+	envPlaceholder = strings.Replace(envPlaceholder, "process.env.", "", -1)
+	envVars := utils.GetEnvVarsFromDotEnvFiles(path, getDotEnvFilenames())
+	if port, err := utils.GetValidPort(envVars[envPlaceholder]); err == nil {
+		return port
+	}
+	return -1
+}
+
+// resolveEnvPort returns the port value of an env var. It looks first locally, then inside the dockerfile
+// and, finally, inside the dotenv files. It returns -1 if no valid port is found.
+func resolveEnvPort(envPlaceholder string, path string) int {
+	if port := GetEnvPort(envPlaceholder); port > 0 {
+		return port
+	}
+	if port := GetEnvPortFromDockerfile(envPlaceholder, path); port > 0 {
+		return port
+	}
+	return GetEnvPortFromDotEnvFiles(envPlaceholder, path)
+}
+
 func getPorts(content string, matchIndexes []int, path string) []int {
 	// Express configures its port with app.listen()
 	portPlaceholder := content[matchIndexes[0]:matchIndexes[1]]
 	portPlaceholder = strings.Replace(portPlaceholder, ".listen(", "", -1)
+	return getPortsFromPlaceholder(content, matchIndexes, portPlaceholder, path)
+}
+
+// getPortsFromPlaceholder returns the ports of a port placeholder found at matchIndexes inside content.
+// The placeholder can be a raw value, an env var or a variable assigned before the match.
+func getPortsFromPlaceholder(content string, matchIndexes []int, portPlaceholder string, path string) []int {
+	// Conversions are not relevant -> Number(process.env.PORT ?? 3000) is handled as process.env.PORT ?? 3000
+	portPlaceholder = unwrapPortValue(portPlaceholder)
 
 	// Case: Raw port value -> return it directly
 	if port, err := utils.GetValidPort(portPlaceholder); err == nil {
 		return []int{port}
 	}
 
+	// Case: Port destructured from process.env -> const { PORT = 3000 } = process.env
+	if envVar, defaultValue, found := getDestructuredEnvVar(content[0:matchIndexes[0]], portPlaceholder); found {
+		return getPortsFromEnvOrDefault("process.env."+envVar, defaultValue, path)
+	}
+
 	// Case: Env var given as value in app.listen -> Get env value
 	// example: app.listen(process.env.PORT...
 	re := regexp.MustCompile(`process.env.[^ ,)]+`)
@@ -128,8 +161,8 @@ func getPorts(content string, matchIndexes []int, path string) []int {
 		// Case: Var Port with env var as value
 		potentialPortGroup := getPortGroup(content, matchIndexes, portPlaceholder)
 		if potentialPortGroup != "" {
-			// Takes into account cases like -> var PORT = process.env.PORT || 8080
-			portValues := strings.Split(potentialPortGroup, " || ")
+			// Takes into account cases like -> var PORT = process.env.PORT || 8080 or process.env.PORT ?? 8080
+			portValues := splitPortFallbacks(unwrapPortValue(potentialPortGroup))
 			for _, portValue := range portValues {
 				re = regexp.MustCompile(`process.env.[^ ,)]+`)
 				tmpMatchIndexes := re.FindStringSubmatchIndex(portValue)
@@ -145,23 +178,17 @@ func getPorts(content string, matchIndexes []int, path string) []int {
 	// After double-checking for env vars try to get the value of this port
 	if len(envMatchIndexes) > 1 {
 		envPlaceholder := envPortValue[envMatchIndexes[0]:envMatchIndexes[1]]
-		port := GetEnvPort(envPlaceholder)
-		// The port will be return only if a value was found for the given env var
-		if port > 0 {
+		// The port will be return only if a value was found for the given env var,
+		// either on system, in a root dockerfile or in the dotenv files
+		if port := resolveEnvPort(envPlaceholder, path); port > 0 {
 			result = append(result, port)
-		} else {
-			// If no env var was found on system try to find one in a root dockerfile
-			port = GetEnvPortFromDockerfile(envPlaceholder, path)
-			if port > 0 {
-				result = append(result, port)
-			}
 		}
 	}
 	// Case: No env var or raw value found -> check for raw value into a var
 	potentialPortGroup := getPortGroup(content, matchIndexes, portPlaceholder)
 	if potentialPortGroup != "" {
-		// Takes into account cases like -> var PORT = process.env.PORT || 8080
-		portValues := strings.Split(potentialPortGroup, " || ")
+		// Takes into account cases like -> var PORT = process.env.PORT || 8080 or process.env.PORT ?? 8080
+		portValues := splitPortFallbacks(unwrapPortValue(potentialPortGroup))
 		for _, portValue := range portValues {
 			if port, err := utils.GetValidPort(portValue); err == nil {
 				result = append(result, port)
@@ -169,5 +196,26 @@ func getPorts(content string, matchIndexes []int, path string) []int {
 			}
 		}
 	}
+	// Case: Raw value as fallback of an env var -> app.listen(process.env.PORT || 3000)
+	if potentialPortGroup == "" {
+		for _, portValue := range splitPortFallbacks(portPlaceholder) {
+			if port, err := utils.GetValidPort(portValue); err == nil {
+				result = append(result, port)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// getPortsFromEnvOrDefault returns the port set with the env var, resolved with resolveEnvPort, and the default port
+func getPortsFromEnvOrDefault(envPlaceholder string, defaultValue string, path string) []int {
+	var result []int
+	if port := resolveEnvPort(envPlaceholder, path); port > 0 {
+		result = append(result, port)
+	}
+	if port, err := utils.GetValidPort(defaultValue); err == nil {
+		result = append(result, port)
+	}
 	return result
 }
@@ -0,0 +1,48 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type FastifyDetector struct{}
+
+func (f FastifyDetector) GetSupportedFrameworks() []string {
+	return []string{"Fastify"}
+}
+
+func (f FastifyDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return getNodeApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (f FastifyDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "fastify") {
+		language.Frameworks = append(language.Frameworks, "Fastify")
+	}
+}
+
+// DoPortsDetection searches for the port passed to fastify.listen({ port }) or, with the legacy signature, fastify.listen(port)
+func (f FastifyDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	regexes := []*regexp.Regexp{
+		regexp.MustCompile(`\.listen\(\s*\{[^}]*?\bport\b\s*(?::\s*([^,}]+))?`),
+		regexp.MustCompile(`\.listen\(\s*([^\s,{)][^,)]*)`),
+	}
+	ports := getPortsFromNodeServerCalls(f.GetApplicationFileInfos(component.Path, ctx), regexes, component.Path)
+	if len(ports) > 0 {
+		component.Ports = ports
+	}
+}
@@ -0,0 +1,47 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type GatsbyDetector struct{}
+
+func (g GatsbyDetector) GetSupportedFrameworks() []string {
+	return []string{"Gatsby"}
+}
+
+func (g GatsbyDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	// Gatsby enricher does not apply source code detection.
+	// It only detects ports from the start/dev scripts
+	return []model.ApplicationFileInfo{}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (g GatsbyDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasDependency(config, "gatsby") {
+		language.Frameworks = append(language.Frameworks, "Gatsby")
+	}
+}
+
+// DoPortsDetection searches for the port in package.json
+func (g GatsbyDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	doFrontendPortsDetection(component, []string{})
+}
+
+// GetDefaultPort returns the Gatsby default port, used when no detector of the component found a port
+func (g GatsbyDetector) GetDefaultPort(componentPath string) int {
+	return 8000
+}
@@ -0,0 +1,48 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type HapiDetector struct{}
+
+func (h HapiDetector) GetSupportedFrameworks() []string {
+	return []string{"Hapi"}
+}
+
+func (h HapiDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return getNodeApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (h HapiDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "@hapi/hapi") {
+		language.Frameworks = append(language.Frameworks, "Hapi")
+	}
+}
+
+// DoPortsDetection searches for the port set when creating the server, e.g. Hapi.server({ port: 3000 }),
+// or, with the legacy API, server.connection({ port: 3000 })
+func (h HapiDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	regexes := []*regexp.Regexp{
+		regexp.MustCompile(`(?:\.[Ss]erver|\.connection)\(\s*\{[^}]*?\bport\b\s*(?::\s*([^,}]+))?`),
+	}
+	ports := getPortsFromNodeServerCalls(h.GetApplicationFileInfos(component.Path, ctx), regexes, component.Path)
+	if len(ports) > 0 {
+		component.Ports = ports
+	}
+}
@@ -0,0 +1,47 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type KoaDetector struct{}
+
+func (k KoaDetector) GetSupportedFrameworks() []string {
+	return []string{"Koa"}
+}
+
+func (k KoaDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return getNodeApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (k KoaDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "koa") {
+		language.Frameworks = append(language.Frameworks, "Koa")
+	}
+}
+
+// DoPortsDetection searches for the port passed to app.listen()
+func (k KoaDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	regexes := []*regexp.Regexp{
+		regexp.MustCompile(`\.listen\(\s*([^,)]+)`),
+	}
+	ports := getPortsFromNodeServerCalls(k.GetApplicationFileInfos(component.Path, ctx), regexes, component.Path)
+	if len(ports) > 0 {
+		component.Ports = ports
+	}
+}
@@ -0,0 +1,60 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type NestJsDetector struct{}
+
+func (n NestJsDetector) GetSupportedFrameworks() []string {
+	return []string{"NestJS"}
+}
+
+func (n NestJsDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return []model.ApplicationFileInfo{
+		{
+			Context: ctx,
+			Root:    componentPath,
+			Dir:     "src",
+			File:    "main.ts",
+		},
+		{
+			Context: ctx,
+			Root:    componentPath,
+			Dir:     "src",
+			File:    "main.js",
+		},
+	}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (n NestJsDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "@nestjs/core") {
+		language.Frameworks = append(language.Frameworks, "NestJS")
+	}
+}
+
+// DoPortsDetection searches for the port passed to app.listen() inside the bootstrap function of src/main.ts
+func (n NestJsDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	regexes := []*regexp.Regexp{
+		regexp.MustCompile(`\.listen\(\s*([^,)]+)`),
+	}
+	ports := getPortsFromNodeServerCalls(n.GetApplicationFileInfos(component.Path, ctx), regexes, component.Path)
+	if len(ports) > 0 {
+		component.Ports = ports
+	}
+}
@@ -37,7 +37,7 @@ func (n NextDetector) DoFrameworkDetection(language *model.Language, config stri
 	}
 }
 
-// DoPortsDetection searches for the port in package.json
+// DoPortsDetection searches for the port in package.json and the PORT env var
 func (n NextDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
 	regexes := []string{`-p (\d*)`}
 	// check if port is set in start script in package.json
@@ -53,4 +53,10 @@ func (n NextDetector) DoPortsDetection(component *model.Component, ctx *context.
 		component.Ports = []int{port}
 		return
 	}
+
+	// check if port is set with the PORT env var, either on system, in a dockerfile or in the dotenv files
+	port = resolveEnvPort("PORT", component.Path)
+	if utils.IsValidPort(port) {
+		component.Ports = []int{port}
+	}
 }
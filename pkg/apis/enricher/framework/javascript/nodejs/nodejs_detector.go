@@ -12,20 +12,52 @@
 package enricher
 
 import (
+	"context"
+	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
+	"github.com/devfile/alizer/pkg/apis/model"
 	"github.com/devfile/alizer/pkg/schema"
 	"github.com/devfile/alizer/pkg/utils"
 )
 
 type packageScriptFunc func(schema.PackageJson) string
 
+var (
+	// portFallbacksRegex splits values like process.env.PORT || 3000 or process.env.PORT ?? 3000
+	portFallbacksRegex = regexp.MustCompile(`\s*(?:\|\||\?\?)\s*`)
+	// typeDeclarationRegex matches the TypeScript declaration files, e.g. index.d.ts
+	typeDeclarationRegex = regexp.MustCompile(`\.d\.[mc]?ts$`)
+	// portWrapperRegex matches the conversions of a port value, e.g. Number(process.env.PORT ?? 3000) or parseInt(port, 10)
+	portWrapperRegex = regexp.MustCompile(`^\+?\s*(?:Number|parseInt|parseFloat)?\(\s*(.*?)\s*(?:,\s*\d+\s*)?\)?$`)
+	// envDestructuringRegex matches the variables destructured from process.env, e.g. const { PORT = 3000 } = process.env
+	envDestructuringRegex = regexp.MustCompile(`(?:let|const|var)\s*\{([^}]*)\}\s*=\s*process\.env\b`)
+	// serverPortRegex matches the port of the server options of a config file, e.g. server: { port: 3000 }
+	serverPortRegex = regexp.MustCompile(`server\s*:\s*\{[^}]*?\bport\s*:\s*(\d+)`)
+)
+
 // hasFramework uses the package.json to check for framework
 func hasFramework(configFile string, tag string) bool {
 	return utils.IsTagInPackageJsonFile(configFile, tag)
 }
 
+// hasDependency checks if the package.json declares a dependency with exactly the given name.
+// It is used when the name is a substring of other packages (e.g. vite and vitest).
+func hasDependency(configFile string, name string) bool {
+	packageJson, err := utils.GetPackageJsonSchemaFromFile(configFile)
+	if err != nil {
+		return false
+	}
+	for _, dependencies := range []map[string]string{packageJson.Dependencies, packageJson.DevDependencies, packageJson.PeerDependencies} {
+		if _, found := dependencies[name]; found {
+			return true
+		}
+	}
+	return false
+}
+
 func getPortFromStartScript(root string, regexes []string) int {
 	getStartScript := func(packageJson schema.PackageJson) string {
 		return packageJson.Scripts.Start
@@ -40,6 +72,19 @@ func getPortFromDevScript(root string, regexes []string) int {
 	return getPortFromScript(root, getDevScript, regexes)
 }
 
+// getPortFromStartOrDevScript returns the port set in the start script or, if missing, in the dev script
+func getPortFromStartOrDevScript(root string, regexes []string) int {
+	if port := getPortFromStartScript(root, regexes); port != -1 {
+		return port
+	}
+	return getPortFromDevScript(root, regexes)
+}
+
+// getPortFlagRegexes returns the regexes matching the port flag of a CLI command, e.g. --port 3000, --port=3000 or -p 3000
+func getPortFlagRegexes() []string {
+	return []string{`--port[=\s]+(\d+)`, `(?:^|\s)-p\s+(\d+)`}
+}
+
 func getPortFromScript(root string, getScript packageScriptFunc, regexes []string) int {
 	packageJson, err := getPackageJson(root)
 	if err != nil {
@@ -64,3 +109,125 @@ func getPackageJson(root string) (schema.PackageJson, error) {
 	packageJsonPath := filepath.Join(root, "package.json")
 	return utils.GetPackageJsonSchemaFromFile(packageJsonPath)
 }
+
+// unwrapPortValue removes the conversions of a port value, e.g. Number(process.env.PORT ?? 3000) becomes process.env.PORT ?? 3000
+func unwrapPortValue(value string) string {
+	value = strings.TrimSpace(value)
+	if matches := portWrapperRegex.FindStringSubmatch(value); len(matches) > 1 {
+		return matches[1]
+	}
+	return strings.TrimPrefix(value, "+")
+}
+
+// getDestructuredEnvVar returns the env var and its default value when variable is destructured from process.env,
+// e.g. const { PORT = 3000 } = process.env or const { APP_PORT: port = 3000 } = process.env
+func getDestructuredEnvVar(content string, variable string) (string, string, bool) {
+	for _, matches := range envDestructuringRegex.FindAllStringSubmatch(content, -1) {
+		for _, property := range strings.Split(matches[1], ",") {
+			envVar, defaultValue, _ := strings.Cut(property, "=")
+			envVar, localName, found := strings.Cut(envVar, ":")
+			if !found {
+				localName = envVar
+			}
+			if strings.TrimSpace(localName) == variable {
+				return strings.TrimSpace(envVar), strings.Trim(strings.TrimSpace(defaultValue), "'\""), true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// getDotEnvFilenames returns the dotenv files loaded by the JavaScript tooling (e.g. Create React App, Vite, Next.js, dotenv)
+// in development mode, from the highest to the lowest precedence
+func getDotEnvFilenames() []string {
+	return []string{".env.development.local", ".env.local", ".env.development", ".env"}
+}
+
+// splitPortFallbacks returns the values of a port expression with fallbacks, e.g. process.env.PORT || 3000
+func splitPortFallbacks(value string) []string {
+	return portFallbacksRegex.Split(strings.TrimSpace(value), -1)
+}
+
+// getNodeApplicationFileInfos returns the JavaScript and TypeScript files of the component (.js, .mjs, .cjs, .ts, .mts, .cts).
+// Type declarations (.d.ts, .d.mts, .d.cts) are excluded.
+func getNodeApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	files, err := utils.GetCachedFilePathsFromRoot(componentPath, ctx)
+	if err != nil {
+		return []model.ApplicationFileInfo{}
+	}
+	var sourceFiles []string
+	for _, file := range files {
+		if !typeDeclarationRegex.MatchString(file) {
+			sourceFiles = append(sourceFiles, file)
+		}
+	}
+	var appFileInfos []model.ApplicationFileInfo
+	for _, extension := range []string{".js", ".mjs", ".cjs", ".ts", ".mts", ".cts"} {
+		appFileInfos = append(appFileInfos, utils.GenerateApplicationFileFromFilters(sourceFiles, componentPath, extension, ctx)...)
+	}
+	return appFileInfos
+}
+
+// getPortsFromNodeServerCalls searches the application files for the port passed to the server calls matched by regexes.
+// The first group of each regex is the port placeholder; if the group is empty (e.g. { port }) the placeholder is "port".
+// The placeholder is resolved with getPortsFromPlaceholder, so env vars are read from the system and the Dockerfile.
+func getPortsFromNodeServerCalls(appFileInfos []model.ApplicationFileInfo, regexes []*regexp.Regexp, path string) []int {
+	fileContents, err := utils.GetApplicationFileContents(appFileInfos)
+	if err != nil {
+		return []int{}
+	}
+	var ports []int
+	for _, content := range fileContents {
+		for _, re := range regexes {
+			for _, matchIndexes := range re.FindAllStringSubmatchIndex(content, -1) {
+				portPlaceholder := "port"
+				if matchIndexes[2] != -1 {
+					portPlaceholder = content[matchIndexes[2]:matchIndexes[3]]
+				}
+				for _, value := range splitPortFallbacks(unwrapPortValue(portPlaceholder)) {
+					if portList := getPortsFromPlaceholder(content, matchIndexes, value, path); len(portList) > 0 {
+						ports = append(ports, portList[0])
+						break
+					}
+				}
+			}
+		}
+		if len(ports) > 0 {
+			return ports
+		}
+	}
+	return ports
+}
+
+// getViteConfigFilenames returns the names of the Vite config file
+func getViteConfigFilenames() []string {
+	return []string{"vite.config.js", "vite.config.ts", "vite.config.mjs", "vite.config.mts", "vite.config.cjs"}
+}
+
+// getPortFromServerConfig returns the server.port set inside the first config file found in root, e.g. vite.config.ts
+func getPortFromServerConfig(root string, configFilenames []string) int {
+	for _, configFilename := range configFilenames {
+		bytes, err := os.ReadFile(filepath.Join(root, configFilename))
+		if err != nil {
+			continue
+		}
+		if port := utils.FindPortSubmatch(serverPortRegex, string(bytes), 1); port != -1 {
+			return port
+		}
+	}
+	return -1
+}
+
+// doFrontendPortsDetection searches for the port of a frontend framework in the --port flag of the start and dev scripts,
+// then in the server.port of the config files. The default port of the framework is applied by the enricher
+// once all the detectors of the component ran without finding a port.
+func doFrontendPortsDetection(component *model.Component, configFilenames []string) {
+	if port := getPortFromStartOrDevScript(component.Path, getPortFlagRegexes()); utils.IsValidPort(port) {
+		component.Ports = []int{port}
+		return
+	}
+
+	if port := getPortFromServerConfig(component.Path, configFilenames); utils.IsValidPort(port) {
+		component.Ports = []int{port}
+	}
+}
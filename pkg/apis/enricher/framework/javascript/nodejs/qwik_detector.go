@@ -0,0 +1,47 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type QwikDetector struct{}
+
+func (q QwikDetector) GetSupportedFrameworks() []string {
+	return []string{"Qwik"}
+}
+
+func (q QwikDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	// Qwik enricher does not apply source code detection.
+	// It only detects ports from the start/dev scripts and the config file
+	return []model.ApplicationFileInfo{}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (q QwikDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "@builder.io/qwik") {
+		language.Frameworks = append(language.Frameworks, "Qwik")
+	}
+}
+
+// DoPortsDetection searches for the port in package.json and vite.config.*
+func (q QwikDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	doFrontendPortsDetection(component, getViteConfigFilenames())
+}
+
+// GetDefaultPort returns the Vite default port, used when no detector of the component found a port
+func (q QwikDetector) GetDefaultPort(componentPath string) int {
+	return 5173
+}
@@ -38,7 +38,7 @@ func (r ReactJsDetector) DoFrameworkDetection(language *model.Language, config s
 	}
 }
 
-// DoPortsDetection searches for the port in the env var, .env file, and package.json
+// DoPortsDetection searches for the port in the env var, dotenv files, and package.json
 func (r ReactJsDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
 	// check if port is set on env var
 	portValue := os.Getenv("PORT")
@@ -46,8 +46,8 @@ func (r ReactJsDetector) DoPortsDetection(component *model.Component, ctx *conte
 		component.Ports = []int{port}
 		return
 	}
-	// check if port is set on .env file
-	port := utils.GetPortValueFromEnvFile(component.Path, `PORT=(\d*)`)
+	// check if port is set on the dotenv files
+	port := GetEnvPortFromDotEnvFiles("PORT", component.Path)
 	if utils.IsValidPort(port) {
 		component.Ports = []int{port}
 		return
@@ -0,0 +1,52 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type RemixDetector struct{}
+
+func (r RemixDetector) GetSupportedFrameworks() []string {
+	return []string{"Remix"}
+}
+
+func (r RemixDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	// Remix enricher does not apply source code detection.
+	// It only detects ports from the start/dev scripts and the config file
+	return []model.ApplicationFileInfo{}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (r RemixDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "@remix-run/") {
+		language.Frameworks = append(language.Frameworks, "Remix")
+	}
+}
+
+// DoPortsDetection searches for the port in package.json and vite.config.*
+func (r RemixDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	doFrontendPortsDetection(component, getViteConfigFilenames())
+}
+
+// GetDefaultPort returns the default port, used when no detector of the component found a port:
+// 5173 for Remix projects built with Vite and 3000 for the classic Remix compiler
+func (r RemixDetector) GetDefaultPort(componentPath string) int {
+	if hasDependency(filepath.Join(componentPath, "package.json"), "vite") {
+		return 5173
+	}
+	return 3000
+}
@@ -0,0 +1,47 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type SolidStartDetector struct{}
+
+func (s SolidStartDetector) GetSupportedFrameworks() []string {
+	return []string{"SolidStart"}
+}
+
+func (s SolidStartDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	// SolidStart enricher does not apply source code detection.
+	// It only detects ports from the start/dev scripts
+	return []model.ApplicationFileInfo{}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (s SolidStartDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasDependency(config, "@solidjs/start") || hasDependency(config, "solid-start") {
+		language.Frameworks = append(language.Frameworks, "SolidStart")
+	}
+}
+
+// DoPortsDetection searches for the port in package.json
+func (s SolidStartDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	doFrontendPortsDetection(component, []string{})
+}
+
+// GetDefaultPort returns the SolidStart default port, used when no detector of the component found a port
+func (s SolidStartDetector) GetDefaultPort(componentPath string) int {
+	return 3000
+}
@@ -0,0 +1,47 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type ViteDetector struct{}
+
+func (v ViteDetector) GetSupportedFrameworks() []string {
+	return []string{"Vite"}
+}
+
+func (v ViteDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	// Vite enricher does not apply source code detection.
+	// It only detects ports from the start/dev scripts and the config file
+	return []model.ApplicationFileInfo{}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (v ViteDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasDependency(config, "vite") {
+		language.Frameworks = append(language.Frameworks, "Vite")
+	}
+}
+
+// DoPortsDetection searches for the port in package.json and vite.config.*
+func (v ViteDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	doFrontendPortsDetection(component, getViteConfigFilenames())
+}
+
+// GetDefaultPort returns the Vite default port, used when no detector of the component found a port
+func (v ViteDetector) GetDefaultPort(componentPath string) int {
+	return 5173
+}
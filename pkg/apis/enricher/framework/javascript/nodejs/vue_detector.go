@@ -43,7 +43,7 @@ func (v VueDetector) DoFrameworkDetection(language *model.Language, config strin
 	}
 }
 
-// DoPortsDetection searches for the port in package.json, .env file, and vue.config.js
+// DoPortsDetection searches for the port in package.json, dotenv files, and vue.config.js
 func (v VueDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
 	regexes := []string{`--port (\d*)`, `PORT=(\d*)`}
 	var ports []int
@@ -59,8 +59,8 @@ func (v VueDetector) DoPortsDetection(component *model.Component, ctx *context.C
 		component.Ports = []int{port}
 	}
 
-	// check if port is set on .env file
-	port = utils.GetPortValueFromEnvFile(component.Path, `PORT=(\d*)`)
+	// check if port is set on the dotenv files
+	port = GetEnvPortFromDotEnvFiles("PORT", component.Path)
 	if utils.IsValidPort(port) {
 		component.Ports = []int{port}
 		return
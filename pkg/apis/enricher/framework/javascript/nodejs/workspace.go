@@ -0,0 +1,447 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+// importRegex matches the modules imported or required by a JavaScript/TypeScript file
+var importRegex = regexp.MustCompile(`(?:\bfrom\s*|\bimport\s*\(?\s*|\brequire\s*\(\s*)["']([^"']+)["']`)
+
+type key string
+
+// WorkspaceProject is an application or a library belonging to a JavaScript monorepo
+type WorkspaceProject struct {
+	Name        string
+	PackageName string
+	Path        string
+}
+
+// GetWorkspaceTool returns the monorepo tool (Nx, Turborepo or Lerna) configured inside root
+func GetWorkspaceTool(root string) string {
+	switch {
+	case isFileInDir(root, "nx.json"):
+		return "Nx"
+	case isFileInDir(root, "turbo.json"):
+		return "Turborepo"
+	case isFileInDir(root, "lerna.json"):
+		return "Lerna"
+	}
+	return ""
+}
+
+// GetWorkspaceRoot walks up from path and returns the first directory configured as a monorepo root.
+// The walk stops at the root of the repository (the first directory under version control) so that folders
+// outside of the analyzed project are never taken into account. An empty string is returned if path does
+// not belong to a monorepo.
+func GetWorkspaceRoot(path string) string {
+	dir, err := filepath.Abs(path)
+	if err != nil {
+		return ""
+	}
+	for {
+		if isWorkspaceRoot(dir) {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir || utils.IsVCSRoot(dir) {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// isWorkspaceRoot checks if dir is configured by a monorepo tool or declares npm, Yarn or pnpm workspaces
+func isWorkspaceRoot(dir string) bool {
+	return GetWorkspaceTool(dir) != "" || len(getWorkspaceGlobs(dir)) > 0
+}
+
+// IsWorkspaceAggregator checks if dir is the root of a monorepo which only groups its projects.
+// Nx standalone repositories keep their single project at the root, so they are not aggregators.
+func IsWorkspaceAggregator(dir string) bool {
+	return isWorkspaceRoot(dir) && !isFileInDir(dir, "project.json")
+}
+
+// IsNxProject checks if the project.json inside dir describes a project of an Nx workspace
+func IsNxProject(dir string) bool {
+	root := GetWorkspaceRoot(dir)
+	return root != "" && isFileInDir(root, "nx.json") && isFileInDir(dir, "project.json")
+}
+
+// GetWorkspaceProjects returns all projects of the monorepo inside root. Projects are the packages matched
+// by the workspace globs and, for Nx, every folder with a project.json. They are looked up once per analysis
+// and cached inside ctx, so the other projects of the same monorepo reuse them.
+func GetWorkspaceProjects(root string, ctx *context.Context) []WorkspaceProject {
+	projectsFromRoot := getWorkspaceProjectsFromContext(*ctx)
+	if projects, cached := projectsFromRoot[root]; cached {
+		return projects
+	}
+	projects := getWorkspaceProjects(root, ctx)
+	projectsFromRoot[root] = projects
+	*ctx = context.WithValue(*ctx, key("mapWorkspaceProjectsFromRoot"), projectsFromRoot)
+	return projects
+}
+
+func getWorkspaceProjectsFromContext(ctx context.Context) map[string][]WorkspaceProject {
+	projectsFromRoot := ctx.Value(key("mapWorkspaceProjectsFromRoot"))
+	if projectsFromRoot != nil {
+		return projectsFromRoot.(map[string][]WorkspaceProject)
+	}
+	return make(map[string][]WorkspaceProject)
+}
+
+func getWorkspaceProjects(root string, ctx *context.Context) []WorkspaceProject {
+	var dirs []string
+	if globs := getWorkspaceGlobs(root); len(globs) > 0 {
+		dirs = getWorkspacePackageDirs(root, globs, ctx)
+	}
+	if isFileInDir(root, "nx.json") {
+		for _, dir := range getNxProjectDirs(root, ctx) {
+			dirs = appendIfMissingPath(dirs, dir)
+		}
+	}
+
+	var projects []WorkspaceProject
+	for _, dir := range dirs {
+		project := WorkspaceProject{
+			Name: GetWorkspaceProjectName(dir),
+			Path: dir,
+		}
+		if packageJson, err := utils.GetPackageJsonSchemaFromFile(filepath.Join(dir, "package.json")); err == nil {
+			project.PackageName = packageJson.Name
+		}
+		projects = append(projects, project)
+	}
+	return projects
+}
+
+// getWorkspaceGlobs returns the globs matching the packages of the workspace inside root. They are read from
+// pnpm-workspace.yaml, the workspaces of package.json and the packages of lerna.json (packages/* by default).
+func getWorkspaceGlobs(root string) []string {
+	var globs []string
+	if pnpmWorkspaceYaml, err := utils.GetPnpmWorkspaceYamlSchemaFromFile(filepath.Join(root, "pnpm-workspace.yaml")); err == nil {
+		globs = append(globs, pnpmWorkspaceYaml.Packages...)
+	}
+	if packageJson, err := utils.GetPackageJsonSchemaFromFile(filepath.Join(root, "package.json")); err == nil {
+		globs = append(globs, packageJson.Workspaces...)
+	}
+	if lernaJson, err := utils.GetLernaJsonSchemaFromFile(filepath.Join(root, "lerna.json")); err == nil && !lernaJson.UseWorkspaces {
+		if len(lernaJson.Packages) == 0 {
+			lernaJson.Packages = []string{"packages/*"}
+		}
+		globs = append(globs, lernaJson.Packages...)
+	}
+	return globs
+}
+
+// getWorkspacePackageDirs returns the folders under root with a package.json matched by the globs.
+// Globs support ** to match any number of folders and are excluded if they start with !.
+func getWorkspacePackageDirs(root string, globs []string, ctx *context.Context) []string {
+	var dirs []string
+	for _, path := range getWorkspacePaths(root, ctx) {
+		if filepath.Base(path) != "package.json" || filepath.Dir(path) == root {
+			continue
+		}
+		relDir, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			continue
+		}
+		if isMatchedByWorkspaceGlobs(globs, relDir) {
+			dirs = append(dirs, filepath.Dir(path))
+		}
+	}
+	return dirs
+}
+
+// isMatchedByWorkspaceGlobs checks if the folder relative to the workspace root is included by a glob and not
+// excluded by a glob starting with !
+func isMatchedByWorkspaceGlobs(globs []string, relDir string) bool {
+	included := false
+	for _, glob := range globs {
+		if excludedGlob, found := strings.CutPrefix(glob, "!"); found {
+			if matchWorkspaceGlob(excludedGlob, relDir) {
+				return false
+			}
+		} else if matchWorkspaceGlob(glob, relDir) {
+			included = true
+		}
+	}
+	return included
+}
+
+// matchWorkspaceGlob checks if the folder relative to the workspace root matches the glob
+func matchWorkspaceGlob(glob string, relDir string) bool {
+	glob = strings.Trim(filepath.ToSlash(filepath.Clean(glob)), "/")
+	return matchGlobSegments(strings.Split(glob, "/"), strings.Split(filepath.ToSlash(relDir), "/"))
+}
+
+func matchGlobSegments(globSegments []string, dirSegments []string) bool {
+	if len(globSegments) == 0 {
+		return len(dirSegments) == 0
+	}
+	if globSegments[0] == "**" {
+		for i := 0; i <= len(dirSegments); i++ {
+			if matchGlobSegments(globSegments[1:], dirSegments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(dirSegments) == 0 {
+		return false
+	}
+	if match, err := filepath.Match(globSegments[0], dirSegments[0]); err != nil || !match {
+		return false
+	}
+	return matchGlobSegments(globSegments[1:], dirSegments[1:])
+}
+
+// getNxProjectDirs returns the folders under root holding a project.json
+func getNxProjectDirs(root string, ctx *context.Context) []string {
+	var dirs []string
+	for _, file := range getWorkspacePaths(root, ctx) {
+		if filepath.Base(file) == "project.json" && filepath.Dir(file) != root {
+			dirs = append(dirs, filepath.Dir(file))
+		}
+	}
+	return dirs
+}
+
+// getWorkspacePaths returns the paths of the files and folders of the monorepo inside root, leaving out the
+// paths ignored by its .gitignore, the excluded folders (e.g. node_modules) and the hidden folders (e.g. .nx)
+func getWorkspacePaths(root string, ctx *context.Context) []string {
+	paths, err := utils.GetCachedFilePathsFromRoot(root, ctx)
+	if err != nil {
+		return []string{}
+	}
+	var workspacePaths []string
+	for _, path := range paths {
+		if relativePath, err := filepath.Rel(root, path); err == nil && relativePath != "." && !isInHiddenFolder(relativePath) {
+			workspacePaths = append(workspacePaths, path)
+		}
+	}
+	return workspacePaths
+}
+
+// isInHiddenFolder checks if the path relative to the monorepo root is inside a folder starting with a dot
+func isInHiddenFolder(relativePath string) bool {
+	for _, folder := range strings.Split(filepath.ToSlash(filepath.Dir(relativePath)), "/") {
+		if strings.HasPrefix(folder, ".") && folder != "." {
+			return true
+		}
+	}
+	return false
+}
+
+// GetWorkspaceProjectName returns the name of the project inside dir. The name set in project.json is
+// used first, then the one of package.json.
+func GetWorkspaceProjectName(dir string) string {
+	if projectJson, err := utils.GetNxProjectJsonSchemaFromFile(filepath.Join(dir, "project.json")); err == nil && projectJson.Name != "" {
+		return projectJson.Name
+	}
+	if packageJson, err := utils.GetPackageJsonSchemaFromFile(filepath.Join(dir, "package.json")); err == nil && packageJson.Name != "" {
+		return packageJson.Name
+	}
+	return filepath.Base(dir)
+}
+
+// IsWorkspaceLibrary checks if the project inside dir is a library. The projectType of project.json is used
+// if set, otherwise a project is a library when it has neither a serve/dev/start target nor script.
+func IsWorkspaceLibrary(dir string) bool {
+	projectJson, err := utils.GetNxProjectJsonSchemaFromFile(filepath.Join(dir, "project.json"))
+	if err == nil {
+		if projectJson.ProjectType != "" {
+			return projectJson.ProjectType == "library"
+		}
+		for _, target := range []string{"serve", "dev", "start"} {
+			if _, found := projectJson.Targets[target]; found {
+				return false
+			}
+		}
+	}
+	packageJson, err := utils.GetPackageJsonSchemaFromFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return true
+	}
+	return packageJson.Scripts.Start == "" && packageJson.Scripts.Serve == "" && packageJson.Scripts.Dev == ""
+}
+
+// GetWorkspaceDependencies returns the sorted names of the projects the project inside dir depends on.
+// Dependencies are taken from the package.json dependencies, the Nx implicitDependencies and the imports
+// of the path aliases declared in the tsconfig of the monorepo root.
+func GetWorkspaceDependencies(root string, dir string, projects []WorkspaceProject, ctx *context.Context) []string {
+	var dependencies []string
+	addDependency := func(name string) {
+		if name != "" && name != GetWorkspaceProjectName(dir) && !utils.Contains(dependencies, name) {
+			dependencies = append(dependencies, name)
+		}
+	}
+
+	if packageJson, err := utils.GetPackageJsonSchemaFromFile(filepath.Join(dir, "package.json")); err == nil {
+		for _, deps := range []map[string]string{packageJson.Dependencies, packageJson.DevDependencies, packageJson.PeerDependencies} {
+			for dependency := range deps {
+				for _, project := range projects {
+					if project.PackageName == dependency {
+						addDependency(project.Name)
+					}
+				}
+			}
+		}
+	}
+	if projectJson, err := utils.GetNxProjectJsonSchemaFromFile(filepath.Join(dir, "project.json")); err == nil {
+		for _, dependency := range projectJson.ImplicitDependencies {
+			if !strings.HasPrefix(dependency, "!") {
+				addDependency(dependency)
+			}
+		}
+	}
+	for _, dependency := range getWorkspaceDependenciesFromImports(root, dir, projects, ctx) {
+		addDependency(dependency)
+	}
+
+	sort.Strings(dependencies)
+	return dependencies
+}
+
+// getWorkspaceDependenciesFromImports returns the names of the projects imported by the source files inside
+// dir through the path aliases (e.g. @org/shared) of tsconfig.base.json or tsconfig.json
+func getWorkspaceDependenciesFromImports(root string, dir string, projects []WorkspaceProject, ctx *context.Context) []string {
+	aliases := map[string]string{}
+	for _, tsConfigFile := range []string{"tsconfig.base.json", "tsconfig.json"} {
+		tsConfigJson, err := utils.GetTsConfigJsonSchemaFromFile(filepath.Join(root, tsConfigFile))
+		if err != nil {
+			continue
+		}
+		for alias, paths := range tsConfigJson.CompilerOptions.Paths {
+			if len(paths) == 0 {
+				continue
+			}
+			if project, found := getWorkspaceProjectByFile(filepath.Join(root, paths[0]), projects); found {
+				aliases[strings.TrimSuffix(alias, "*")] = project.Name
+			}
+		}
+		break
+	}
+	if len(aliases) == 0 {
+		return []string{}
+	}
+
+	var dependencies []string
+	for _, file := range getWorkspacePaths(root, ctx) {
+		if !strings.HasPrefix(file, dir+string(os.PathSeparator)) {
+			continue
+		}
+		if !utils.Contains([]string{".js", ".jsx", ".mjs", ".cjs", ".ts", ".tsx", ".mts", ".cts"}, filepath.Ext(file)) {
+			continue
+		}
+		bytes, err := os.ReadFile(filepath.Clean(file))
+		if err != nil {
+			continue
+		}
+		for _, match := range importRegex.FindAllStringSubmatch(string(bytes), -1) {
+			for alias, name := range aliases {
+				if match[1] == alias || (strings.HasSuffix(alias, "/") && strings.HasPrefix(match[1], alias)) {
+					dependencies = append(dependencies, name)
+				}
+			}
+		}
+	}
+	return dependencies
+}
+
+// getWorkspaceProjectByFile returns the project containing the file
+func getWorkspaceProjectByFile(file string, projects []WorkspaceProject) (WorkspaceProject, bool) {
+	file = filepath.Clean(file)
+	for _, project := range projects {
+		if file == project.Path || strings.HasPrefix(file, project.Path+string(os.PathSeparator)) {
+			return project, true
+		}
+	}
+	return WorkspaceProject{}, false
+}
+
+// GetPortFromNxProjectJson returns the port set in the options of the serve or dev target of project.json
+func GetPortFromNxProjectJson(dir string) int {
+	projectJson, err := utils.GetNxProjectJsonSchemaFromFile(filepath.Join(dir, "project.json"))
+	if err != nil {
+		return -1
+	}
+	for _, target := range []string{"serve", "dev"} {
+		switch port := projectJson.Targets[target].Options["port"].(type) {
+		case float64:
+			if utils.IsValidPort(int(port)) {
+				return int(port)
+			}
+		case string:
+			if value, err := strconv.Atoi(port); err == nil && utils.IsValidPort(value) {
+				return value
+			}
+		}
+	}
+	return -1
+}
+
+// GetFrameworksFromNxExecutors returns the frameworks targeted by the executors of the project.json
+// inside dir, e.g. @nx/next:server for Next
+func GetFrameworksFromNxExecutors(dir string) []string {
+	projectJson, err := utils.GetNxProjectJsonSchemaFromFile(filepath.Join(dir, "project.json"))
+	if err != nil {
+		return []string{}
+	}
+	executorFrameworks := map[string]string{
+		"@angular-devkit/build-angular": "Angular",
+		"@nx/angular":                   "Angular",
+		"@nx/next":                      "Next",
+		"@nx/react":                     "React",
+		"@nx/remix":                     "Remix",
+		"@nx/vite":                      "Vite",
+		"@nx/vue":                       "Vue",
+		"@nrwl/angular":                 "Angular",
+		"@nrwl/next":                    "Next",
+		"@nrwl/react":                   "React",
+		"@nrwl/vite":                    "Vite",
+	}
+	var frameworks []string
+	var targets []string
+	for target := range projectJson.Targets {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+	for _, target := range targets {
+		plugin, _, _ := strings.Cut(projectJson.Targets[target].Executor, ":")
+		if framework, found := executorFrameworks[plugin]; found && !utils.Contains(frameworks, framework) {
+			frameworks = append(frameworks, framework)
+		}
+	}
+	return frameworks
+}
+
+func appendIfMissingPath(dirs []string, dir string) []string {
+	if utils.Contains(dirs, dir) {
+		return dirs
+	}
+	return append(dirs, dir)
+}
+
+func isFileInDir(dir string, filename string) bool {
+	_, err := os.Stat(filepath.Join(dir, filename))
+	return err == nil
+}
@@ -0,0 +1,37 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type AioHttpDetector struct{}
+
+func (d AioHttpDetector) GetSupportedFrameworks() []string {
+	return []string{"aiohttp"}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+// with the application files and the config files
+func (d AioHttpDetector) DoFrameworkDetection(language *model.Language, files *[]string) {
+	if isFrameworkInFiles(files, getPythonAppFilenames(), getPythonConfigFilenames(), "aiohttp") {
+		language.Frameworks = append(language.Frameworks, "aiohttp")
+	}
+}
+
+// DoPortsDetection searches for the port in the --port argument of the server command and web.run_app calls
+func (d AioHttpDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	doPythonServerPortsDetection(component, []string{}, ctx)
+}
@@ -41,7 +41,7 @@ func (d DjangoDetector) GetDjangoFilenames() []string {
 }
 
 func (d DjangoDetector) GetConfigDjangoFilenames() []string {
-	return []string{"requirements.txt", "pyproject.toml"}
+	return []string{"requirements.txt", "pyproject.toml", "Pipfile", "setup.py", "environment.yml"}
 }
 
 // DoFrameworkDetection uses a tag to check for the framework name
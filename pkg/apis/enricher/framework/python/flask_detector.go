@@ -54,7 +54,7 @@ func (f FlaskDetector) GetFlaskFilenames() []string {
 }
 
 func (f FlaskDetector) GetConfigFlaskFilenames() []string {
-	return []string{"requirements.txt", "pyproject.toml"}
+	return []string{"requirements.txt", "pyproject.toml", "Pipfile", "setup.py", "environment.yml"}
 }
 
 // DoFrameworkDetection uses a tag to check for the framework name
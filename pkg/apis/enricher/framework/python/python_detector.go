@@ -12,9 +12,43 @@
 package enricher
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/apis/model"
 	"github.com/devfile/alizer/pkg/utils"
 )
 
+var (
+	// e.g. uvicorn main:app --port 8000 or sanic server.app --port=${PORT:-8000}
+	pythonPortArgRegex = regexp.MustCompile(`(?:^|\s)--port[=\s]+["']?([^\s"']+)`)
+	// e.g. $PORT, ${PORT} or ${PORT:-8000}
+	pythonEnvPlaceholderRegex = regexp.MustCompile(`^\$\{?(\w+)(?::?-(\d+))?\}?$`)
+	// e.g. uvicorn.run(app, port=8000), web.run_app(app, port=8080) or app.run(port=8000)
+	pythonRunPortRegex = regexp.MustCompile(`\.run(?:_app)?\([^)]*?\bport\s*=\s*([\w.]+)`)
+	// e.g. app.listen(8888), server.listen(options.port) or HTTPServer(app).listen(port=8888)
+	pythonListenRegex = regexp.MustCompile(`(?:(\w+)|\))\.listen\(\s*(port\s*=\s*)?([\w.]+)`)
+	// e.g. import tornado.web or from tornado.options import define
+	tornadoImportRegex = regexp.MustCompile(`(?m)^\s*(?:import|from)\s+tornado\b`)
+	// e.g. gunicorn app:app --bind 0.0.0.0:8000, -b :8000 or --bind=0.0.0.0:${PORT:-8000}
+	gunicornBindArgRegex = regexp.MustCompile(`(?:^|\s)(?:--bind|-b)[=\s]+["']?([^\s"']+)`)
+	// e.g. uwsgi --http-socket :9090 or --http=0.0.0.0:9090
+	uwsgiHttpArgRegex = regexp.MustCompile(`(?:^|\s)--http(?:-socket)?[=\s]+["']?([^\s"']+)`)
+	// e.g. bind = "0.0.0.0:8000" or bind = ["0.0.0.0:8000"] inside gunicorn.conf.py
+	gunicornConfBindRegex = regexp.MustCompile(`(?m)^\s*bind\s*=\s*(.+)$`)
+	// e.g. http-socket = :9090 inside the [uwsgi] section of uwsgi.ini
+	uwsgiIniHttpRegex = regexp.MustCompile(`(?m)^\s*http(?:-socket)?\s*=\s*(\S+)`)
+	// e.g. 0.0.0.0:8000, [::]:8000, :8000 or 0.0.0.0:${PORT:-8000}
+	bindAddressRegex = regexp.MustCompile(`^(?:\[[^\]]*\]|[^:\[]*):(.+)$`)
+	// e.g. os.environ.get("PORT", "8000") or os.getenv("PORT", 8000)
+	pythonEnvGetRegex = regexp.MustCompile(`(?:environ\.get|getenv|environ\.setdefault)\(\s*["'](\w+)["']\s*(?:,\s*["']?(\d+)["']?)?`)
+	// e.g. gunicorn app:app, exec uvicorn main:app or python -m hypercorn app:app
+	pythonServerCommandRegex = regexp.MustCompile(`(?:^|[\s/])(?:gunicorn|uvicorn|hypercorn|uwsgi)(?:\s|$)`)
+)
+
 // hasFramework uses all files to check for framework
 func hasFramework(files *[]string, tag string) bool {
 	for _, file := range *files {
@@ -24,3 +58,326 @@ func hasFramework(files *[]string, tag string) bool {
 	}
 	return false
 }
+
+// getPythonAppFilenames returns the files where the application is usually created
+func getPythonAppFilenames() []string {
+	return []string{"main.py", "app.py", "server.py", "asgi.py"}
+}
+
+// getPythonConfigFilenames returns the files where the dependencies of a project are declared
+func getPythonConfigFilenames() []string {
+	return []string{"requirements.txt", "pyproject.toml", "Pipfile", "setup.py", "environment.yml"}
+}
+
+// isFrameworkInFiles checks if the framework module is imported inside one of pythonFilenames or
+// declared as requirement inside one of configFilenames. Modules and requirements are matched by their
+// exact name, so e.g. aiohttp-retry or aiohttp_jinja2 do not mean aiohttp is used.
+func isFrameworkInFiles(files *[]string, pythonFilenames []string, configFilenames []string, module string) bool {
+	var pythonFiles []string
+	var configFiles []string
+
+	for _, filename := range pythonFilenames {
+		filePy := utils.GetFile(files, filename)
+		utils.AddToArrayIfValueExist(&pythonFiles, filePy)
+	}
+
+	for _, filename := range configFilenames {
+		configFile := utils.GetFile(files, filename)
+		utils.AddToArrayIfValueExist(&configFiles, configFile)
+	}
+
+	quotedModule := regexp.QuoteMeta(module)
+	importRegex := regexp.MustCompile(`(?m)^\s*(?:from\s+` + quotedModule + `[\s.]|import\s+` + quotedModule + `(?:[\s.,]|$))`)
+	requirementRegex := regexp.MustCompile(`(?im)(?:^|[^\w.\-])` + quotedModule + `(?:[^\w.\-]|$)`)
+	return isRegexInFiles(pythonFiles, importRegex) || isRegexInFiles(configFiles, requirementRegex)
+}
+
+func isRegexInFiles(files []string, re *regexp.Regexp) bool {
+	for _, file := range files {
+		if bytes, err := os.ReadFile(filepath.Clean(file)); err == nil && re.Match(bytes) {
+			return true
+		}
+	}
+	return false
+}
+
+// doPythonServerPortsDetection searches for the port in the env vars read by the server (e.g. UVICORN_PORT),
+// for the --port argument inside the Dockerfile CMD/ENTRYPOINT and the Procfile and, finally, inside the .py files
+func doPythonServerPortsDetection(component *model.Component, envs []string, ctx *context.Context) {
+	// check if port is set as env var
+	ports := utils.GetValidPortsFromEnvs(envs)
+	if len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+
+	// check if port is set as env var inside a Dockerfile
+	ports, err := utils.GetEnvVarPortValueFromDockerfile(component.Path, envs)
+	if err == nil && len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+
+	// check if port is set as argument of the server command
+	for _, command := range getServerCommands(component.Path) {
+		if port := getPortFromCommand(command, component.Path); port != -1 {
+			component.Ports = []int{port}
+			return
+		}
+	}
+
+	// check source code
+	files, err := utils.GetCachedFilePathsFromRoot(component.Path, ctx)
+	if err != nil {
+		return
+	}
+	fileContents, err := utils.GetApplicationFileContents(utils.GenerateApplicationFileFromFilters(files, component.Path, ".py", ctx))
+	if err != nil {
+		return
+	}
+	for _, fileContent := range fileContents {
+		ports := getPortsFromPythonSource(fileContent, component.Path)
+		if len(ports) > 0 {
+			component.Ports = ports
+			return
+		}
+	}
+}
+
+// getServerCommands returns the CMD and ENTRYPOINT commands of the Dockerfile, the web process of the Procfile and
+// the commands running a Python server (gunicorn, uvicorn, hypercorn or uwsgi) inside the start and dev scripts of
+// the package.json and the shell scripts inside root
+func getServerCommands(root string) []string {
+	commands, _ := utils.GetCommandsFromDockerfile(root)
+	if bytes, err := os.ReadFile(filepath.Join(root, "Procfile")); err == nil {
+		if matches := regexp.MustCompile(`(?m)^web:\s*(.*)$`).FindStringSubmatch(string(bytes)); len(matches) > 1 {
+			commands = append(commands, matches[1])
+		}
+	}
+	if packageJson, err := utils.GetPackageJsonSchemaFromFile(filepath.Join(root, "package.json")); err == nil {
+		commands = append(commands, getPythonServerCommands([]string{packageJson.Scripts.Start, packageJson.Scripts.Dev})...)
+	}
+	shellScripts, _ := filepath.Glob(filepath.Join(root, "*.sh"))
+	for _, shellScript := range shellScripts {
+		if bytes, err := os.ReadFile(filepath.Clean(shellScript)); err == nil {
+			commands = append(commands, getPythonServerCommands(strings.Split(string(bytes), "\n"))...)
+		}
+	}
+	return commands
+}
+
+// getPythonServerCommands returns the commands running a Python server, leaving out the other commands of a script
+// (e.g. pg_isready --port 5432)
+func getPythonServerCommands(commands []string) []string {
+	var serverCommands []string
+	for _, command := range commands {
+		if pythonServerCommandRegex.MatchString(command) {
+			serverCommands = append(serverCommands, command)
+		}
+	}
+	return serverCommands
+}
+
+// DoWSGIServerPortsDetection searches for the port the gunicorn or uwsgi server binds to. It checks the
+// --bind argument of the server command, the GUNICORN_CMD_ARGS env var, gunicorn.conf.py and uwsgi.ini
+func DoWSGIServerPortsDetection(component *model.Component) {
+	for _, command := range getServerCommands(component.Path) {
+		if port := getPortFromWSGIServerCommand(command, component.Path); port != -1 {
+			component.Ports = []int{port}
+			return
+		}
+	}
+
+	if port := getPortFromGunicornCmdArgs(component.Path); port != -1 {
+		component.Ports = []int{port}
+		return
+	}
+
+	for _, gunicornConf := range []string{"gunicorn.conf.py", "gunicorn.conf"} {
+		if port := getPortFromGunicornConf(filepath.Join(component.Path, gunicornConf), component.Path); port != -1 {
+			component.Ports = []int{port}
+			return
+		}
+	}
+
+	if bytes, err := os.ReadFile(filepath.Join(component.Path, "uwsgi.ini")); err == nil {
+		if matches := uwsgiIniHttpRegex.FindStringSubmatch(string(bytes)); len(matches) > 1 {
+			if port := getPortFromBindAddress(matches[1], component.Path); port != -1 {
+				component.Ports = []int{port}
+			}
+		}
+	}
+}
+
+// getPortFromWSGIServerCommand returns the port of the --bind argument of a gunicorn command
+// or of the --http-socket argument of a uwsgi command
+func getPortFromWSGIServerCommand(command string, root string) int {
+	var re *regexp.Regexp
+	if strings.Contains(command, "gunicorn") {
+		re = gunicornBindArgRegex
+	} else if strings.Contains(command, "uwsgi") {
+		re = uwsgiHttpArgRegex
+	} else {
+		return -1
+	}
+	matches := re.FindStringSubmatch(command)
+	if len(matches) < 2 {
+		return -1
+	}
+	return getPortFromBindAddress(matches[1], root)
+}
+
+// getPortFromGunicornCmdArgs returns the port of the --bind argument set inside the GUNICORN_CMD_ARGS env var,
+// either set in the system or inside the Dockerfile
+func getPortFromGunicornCmdArgs(root string) int {
+	cmdArgs := os.Getenv("GUNICORN_CMD_ARGS")
+	if cmdArgs == "" {
+		envVars, _ := utils.GetEnvVarsFromDockerFile(root)
+		for _, envVar := range envVars {
+			if envVar.Name == "GUNICORN_CMD_ARGS" {
+				cmdArgs = strings.Trim(envVar.Value, "\"'")
+			}
+		}
+	}
+	matches := gunicornBindArgRegex.FindStringSubmatch(cmdArgs)
+	if len(matches) < 2 {
+		return -1
+	}
+	return getPortFromBindAddress(matches[1], root)
+}
+
+// getPortFromGunicornConf returns the port of the bind setting of a gunicorn config file,
+// e.g. bind = "0.0.0.0:8000" or bind = "0.0.0.0:" + os.environ.get("PORT", "8000")
+func getPortFromGunicornConf(path string, root string) int {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return -1
+	}
+	matches := gunicornConfBindRegex.FindStringSubmatch(string(bytes))
+	if len(matches) < 2 {
+		return -1
+	}
+	value := matches[1]
+	if envMatches := pythonEnvGetRegex.FindStringSubmatch(value); len(envMatches) > 2 {
+		return getPortFromEnvOrDefault(envMatches[1], envMatches[2], root)
+	}
+	if addressMatches := regexp.MustCompile(`["']([^"']*:[^"']*)["']`).FindStringSubmatch(value); len(addressMatches) > 1 {
+		return getPortFromBindAddress(addressMatches[1], root)
+	}
+	return -1
+}
+
+// getPortFromBindAddress returns the port of a host:port address. The port can be an env var, e.g. 0.0.0.0:${PORT:-8000}
+func getPortFromBindAddress(address string, root string) int {
+	matches := bindAddressRegex.FindStringSubmatch(address)
+	if len(matches) < 2 {
+		return -1
+	}
+	return getPortFromPlaceholder(matches[1], root)
+}
+
+// getPortFromCommand returns the value of the --port argument of a command
+func getPortFromCommand(command string, root string) int {
+	matches := pythonPortArgRegex.FindStringSubmatch(command)
+	if len(matches) < 2 {
+		return -1
+	}
+	return getPortFromPlaceholder(matches[1], root)
+}
+
+// getPortFromPlaceholder returns the port if placeholder is a number. If placeholder is an env var (e.g. ${PORT:-8000})
+// its value, either set in the system or inside the Dockerfile, is returned or the default value if not set.
+func getPortFromPlaceholder(placeholder string, root string) int {
+	if port, err := utils.GetValidPort(placeholder); err == nil {
+		return port
+	}
+	matches := pythonEnvPlaceholderRegex.FindStringSubmatch(placeholder)
+	if len(matches) < 3 {
+		return -1
+	}
+	return getPortFromEnvOrDefault(matches[1], matches[2], root)
+}
+
+func getPortFromEnvOrDefault(envVar string, defaultValue string, root string) int {
+	if ports := utils.GetValidPortsFromEnvs([]string{envVar}); len(ports) > 0 {
+		return ports[0]
+	}
+	if ports, err := utils.GetEnvVarPortValueFromDockerfile(root, []string{envVar}); err == nil && len(ports) > 0 {
+		return ports[0]
+	}
+	if port, err := utils.GetValidPort(defaultValue); err == nil {
+		return port
+	}
+	return -1
+}
+
+// getPortsFromPythonSource returns the ports passed to run or listen calls inside a python file.
+// If the port is a variable, it tries to find its value within the code.
+func getPortsFromPythonSource(content string, root string) []int {
+	var ports []int
+	for _, matchIndexes := range pythonRunPortRegex.FindAllStringSubmatchIndex(content, -1) {
+		if port := getPortFromPythonPlaceholder(content, matchIndexes[0], content[matchIndexes[2]:matchIndexes[3]], root); port != -1 {
+			ports = append(ports, port)
+		}
+	}
+	for _, matchIndexes := range pythonListenRegex.FindAllStringSubmatchIndex(content, -1) {
+		if !isPythonServerListenCall(content, matchIndexes) {
+			continue
+		}
+		if port := getPortFromPythonPlaceholder(content, matchIndexes[0], content[matchIndexes[6]:matchIndexes[7]], root); port != -1 {
+			ports = append(ports, port)
+		}
+	}
+	return ports
+}
+
+// isPythonServerListenCall checks if a listen call starts a server rather than a socket, e.g. sock.listen(5).
+// The port must be passed as keyword or the call must be done on a Tornado application or server.
+func isPythonServerListenCall(content string, matchIndexes []int) bool {
+	if matchIndexes[4] != -1 {
+		return true
+	}
+	if !tornadoImportRegex.MatchString(content) {
+		return false
+	}
+	if matchIndexes[2] == -1 {
+		return true
+	}
+	receiver := regexp.QuoteMeta(content[matchIndexes[2]:matchIndexes[3]])
+	socketRegex := regexp.MustCompile(`\b` + receiver + `\s*=\s*(?:socket\.)?(?:socket|create_server)\(|(?:socket|create_server)\([^)]*\)\s+as\s+` + receiver + `\b`)
+	return !socketRegex.MatchString(content)
+}
+
+func getPortFromPythonPlaceholder(content string, matchStart int, portPlaceholder string, root string) int {
+	if port, err := utils.GetValidPort(portPlaceholder); err == nil {
+		return port
+	}
+	return getPortFromPythonVariable(content, content[0:matchStart], portPlaceholder, root)
+}
+
+// getPortFromPythonVariable looks for the value of variable, e.g. port = 8000 or port = int(os.environ.get("PORT", 8000)).
+// Tornado options (e.g. options.port) are searched inside the define calls.
+func getPortFromPythonVariable(content string, contentBeforeMatch string, variable string, root string) int {
+	if optionName, found := strings.CutPrefix(variable, "options."); found {
+		re := regexp.MustCompile(`define\(\s*["']` + regexp.QuoteMeta(optionName) + `["'][^)]*?default\s*=\s*(\d+)`)
+		return utils.FindPortSubmatch(re, content, 1)
+	}
+
+	re, err := regexp.Compile(`(?m)^\s*` + regexp.QuoteMeta(variable) + `\s*(?::\s*\w+\s*)?=\s*(.+)$`)
+	if err != nil {
+		return -1
+	}
+	allMatches := re.FindAllStringSubmatch(contentBeforeMatch, -1)
+	if len(allMatches) == 0 {
+		return -1
+	}
+	value := allMatches[len(allMatches)-1][1]
+	if port := utils.FindPortSubmatch(regexp.MustCompile(`^(\d+)`), value, 1); port != -1 {
+		return port
+	}
+	envMatches := pythonEnvGetRegex.FindStringSubmatch(value)
+	if len(envMatches) > 2 {
+		return getPortFromEnvOrDefault(envMatches[1], envMatches[2], root)
+	}
+	return -1
+}
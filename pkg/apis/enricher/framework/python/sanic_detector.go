@@ -0,0 +1,37 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type SanicDetector struct{}
+
+func (d SanicDetector) GetSupportedFrameworks() []string {
+	return []string{"Sanic"}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+// with the application files and the config files
+func (d SanicDetector) DoFrameworkDetection(language *model.Language, files *[]string) {
+	if isFrameworkInFiles(files, getPythonAppFilenames(), getPythonConfigFilenames(), "sanic") {
+		language.Frameworks = append(language.Frameworks, "Sanic")
+	}
+}
+
+// DoPortsDetection searches for the port in the --port argument of the server command and app.run calls
+func (d SanicDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	doPythonServerPortsDetection(component, []string{}, ctx)
+}
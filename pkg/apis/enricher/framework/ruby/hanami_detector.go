@@ -0,0 +1,36 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type HanamiDetector struct{}
+
+func (d HanamiDetector) GetSupportedFrameworks() []string {
+	return []string{"Hanami"}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (d HanamiDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "hanami") {
+		language.Frameworks = append(language.Frameworks, "Hanami")
+	}
+}
+
+// DoPortsDetection searches for the port in the Procfiles, config/puma.rb and config.ru
+func (d HanamiDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	DoRubyPortsDetection(component, ctx)
+}
@@ -0,0 +1,36 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type RailsDetector struct{}
+
+func (d RailsDetector) GetSupportedFrameworks() []string {
+	return []string{"Rails"}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (d RailsDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "rails") {
+		language.Frameworks = append(language.Frameworks, "Rails")
+	}
+}
+
+// DoPortsDetection searches for the port in the Procfiles, config/puma.rb and config.ru
+func (d RailsDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	DoRubyPortsDetection(component, ctx)
+}
@@ -0,0 +1,247 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+var (
+	// e.g. ENV.fetch("PORT") { 3000 } or ENV.fetch('PORT', 3000)
+	rubyEnvFetchRegex = regexp.MustCompile(`ENV\.fetch\(\s*["'](\w*PORT\w*)["']\s*(?:,\s*["']?(\d+)["']?\s*)?\)(?:\s*\{\s*["']?(\d+)["']?\s*\})?`)
+	// e.g. ENV["PORT"] || 3000
+	rubyEnvIndexRegex = regexp.MustCompile(`ENV\[\s*["'](\w*PORT\w*)["']\s*\](?:\s*(?:\|\||or)\s*["']?(\d+)["']?)?`)
+	// e.g. web: bundle exec puma -p ${PORT:-3000}
+	procfileEnvDefaultRegex = regexp.MustCompile(`\$\{?(\w*PORT\w*)(?::?-(\d+))?\}?`)
+	// a port number or an env var read, e.g. 4567, ENV.fetch("PORT", 4567) or ENV["PORT"] || 4567
+	rubyPortValue = `(ENV(?:\.fetch\([^)]*\)(?:\s*\{[^}]*\})?|\[[^\]]*\](?:\s*(?:\|\||or)\s*["']?\d+["']?)?)|["']?\d+["']?)`
+	// e.g. set :port, 4567 or set :port, ENV.fetch("PORT", 4567)
+	sinatraSetPortRegex = regexp.MustCompile(`(?m)^\s*set\s+:port\s*,\s*` + rubyPortValue)
+	// e.g. run! port: 4567 or run!(:port => 4567)
+	sinatraRunPortRegex = regexp.MustCompile(`run!\s*\(?[^)\n]*?(?:\bport:|:port\s*=>)\s*` + rubyPortValue)
+)
+
+// hasFramework uses the Gemfile, the Gemfile.lock and the gemspec files found next to configFile to check for framework
+func hasFramework(configFile string, tag string) bool {
+	dir := filepath.Dir(configFile)
+	quotedTag := regexp.QuoteMeta(tag)
+	gemfileRegex := regexp.MustCompile(`(?m)^\s*gem\s+["']` + quotedTag + `["']`)
+	lockRegex := regexp.MustCompile(`(?m)^\s+` + quotedTag + ` \(`)
+	gemspecRegex := regexp.MustCompile(`add_(?:runtime_)?dependency\s*\(?\s*["']` + quotedTag + `["']`)
+
+	if content, err := os.ReadFile(filepath.Join(dir, "Gemfile")); err == nil && gemfileRegex.Match(content) {
+		return true
+	}
+	if content, err := os.ReadFile(filepath.Join(dir, "Gemfile.lock")); err == nil && lockRegex.Match(content) {
+		return true
+	}
+	gemspecs, _ := filepath.Glob(filepath.Join(dir, "*.gemspec"))
+	for _, gemspec := range gemspecs {
+		if content, err := os.ReadFile(filepath.Clean(gemspec)); err == nil && gemspecRegex.Match(content) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRubyApplicationName returns the name set inside a gemspec file of root or, as fallback,
+// the snake case name of the application module defined in config/application.rb (Rails) or config/app.rb (Hanami)
+func GetRubyApplicationName(root string) string {
+	gemspecs, _ := filepath.Glob(filepath.Join(root, "*.gemspec"))
+	for _, gemspec := range gemspecs {
+		content, err := os.ReadFile(filepath.Clean(gemspec))
+		if err != nil {
+			continue
+		}
+		if matches := regexp.MustCompile(`\w+\.name\s*=\s*["']([^"']+)["']`).FindStringSubmatch(string(content)); len(matches) > 1 {
+			return matches[1]
+		}
+	}
+
+	for _, appFile := range []string{filepath.Join("config", "application.rb"), filepath.Join("config", "app.rb")} {
+		content, err := os.ReadFile(filepath.Join(root, appFile))
+		if err != nil {
+			continue
+		}
+		if matches := regexp.MustCompile(`(?m)^module\s+([A-Z]\w*)`).FindStringSubmatch(string(content)); len(matches) > 1 {
+			return toSnakeCase(matches[1])
+		}
+	}
+	return ""
+}
+
+func toSnakeCase(name string) string {
+	var builder strings.Builder
+	for i, char := range name {
+		if unicode.IsUpper(char) {
+			if i > 0 {
+				builder.WriteRune('_')
+			}
+			char = unicode.ToLower(char)
+		}
+		builder.WriteRune(char)
+	}
+	return builder.String()
+}
+
+// DoRubyPortsDetection searches for the port in the Procfiles, config/puma.rb and config.ru of a component
+func DoRubyPortsDetection(component *model.Component, ctx *context.Context) {
+	// check if port is set inside the web process of a Procfile
+	for _, procfile := range []string{"Procfile", "Procfile.dev"} {
+		ports := getPortsFromProcfile(filepath.Join(component.Path, procfile), component.Path)
+		if len(ports) > 0 {
+			component.Ports = ports
+			return
+		}
+	}
+
+	// check if port is set inside the puma or rack configuration
+	for _, configFile := range []string{filepath.Join("config", "puma.rb"), "config.ru"} {
+		content, err := os.ReadFile(filepath.Join(component.Path, configFile))
+		if err != nil {
+			continue
+		}
+		ports := GetPortsFromFileRuby(string(content), component.Path)
+		if len(ports) > 0 {
+			component.Ports = ports
+			return
+		}
+	}
+}
+
+// getPortsFromProcfile returns the ports set inside the web process of a Procfile
+// using the -p, --port or --bind options of the command
+func getPortsFromProcfile(procfile string, root string) []int {
+	content, err := os.ReadFile(filepath.Clean(procfile))
+	if err != nil {
+		return []int{}
+	}
+	webProcess := regexp.MustCompile(`(?m)^web:\s*(.*)$`).FindStringSubmatch(string(content))
+	if len(webProcess) < 2 {
+		return []int{}
+	}
+	command := webProcess[1]
+
+	portRegexes := []*regexp.Regexp{
+		regexp.MustCompile(`(?:^|\s)(?:-p|--port)[=\s]+(\S+)`),
+		regexp.MustCompile(`(?:^|\s)(?:-b|--bind)[=\s]+["']?tcp://[^\s:]*:(\S+?)["']?(?:\s|$)`),
+	}
+	for _, re := range portRegexes {
+		matches := re.FindStringSubmatch(command)
+		if len(matches) < 2 {
+			continue
+		}
+		if port, err := utils.GetValidPort(matches[1]); err == nil {
+			return []int{port}
+		}
+		if envMatches := procfileEnvDefaultRegex.FindStringSubmatch(matches[1]); len(envMatches) > 2 {
+			if port := getPortFromRubyEnv(envMatches[1], envMatches[2], root); port != -1 {
+				return []int{port}
+			}
+		}
+	}
+	return []int{}
+}
+
+// GetPortsFromFileRuby returns the ports set inside a puma or rack configuration file, e.g. `port 3000`,
+// `bind "tcp://0.0.0.0:3000"` or `Port: 3000`. If the port is read from an env var, its value (from the system
+// or from the Dockerfile) is used, falling back to the default value given in the code.
+func GetPortsFromFileRuby(content string, root string) []int {
+	if port := getPortFromRubyEnvRead(content, root); port != -1 {
+		return []int{port}
+	}
+
+	portRegexes := []*regexp.Regexp{
+		regexp.MustCompile(`(?m)^\s*port\s+["']?(\d+)["']?`),
+		regexp.MustCompile(`bind\s+["']tcp://[^"':]*:(\d+)["']`),
+		regexp.MustCompile(`:?[Pp]ort(?::|\s*=>)\s*["']?(\d+)["']?`),
+	}
+	for _, re := range portRegexes {
+		if port := utils.FindPortSubmatch(re, content, 1); port != -1 {
+			return []int{port}
+		}
+	}
+	return []int{}
+}
+
+// GetPortsFromSinatraFile returns the port set inside a Sinatra application file with the `set :port, 4567`
+// setting or passed to `run!`, e.g. `run! port: 4567`. Other port settings, e.g. the one of a Redis client,
+// are not taken into account.
+func GetPortsFromSinatraFile(content string, root string) []int {
+	for _, re := range []*regexp.Regexp{sinatraSetPortRegex, sinatraRunPortRegex} {
+		for _, matches := range re.FindAllStringSubmatch(content, -1) {
+			if port := getPortFromRubyValue(matches[1], root); port != -1 {
+				return []int{port}
+			}
+		}
+	}
+	return []int{}
+}
+
+// getPortFromRubyValue returns the port of a value which is either a number or read from an env var
+func getPortFromRubyValue(value string, root string) int {
+	value = strings.Trim(strings.TrimSpace(value), `"'`)
+	if port, err := utils.GetValidPort(value); err == nil {
+		return port
+	}
+	return getPortFromRubyEnvRead(value, root)
+}
+
+// getPortFromRubyEnvRead returns the port of the first env var read inside content,
+// e.g. ENV.fetch("PORT") { 3000 } or ENV["PORT"] || 3000
+func getPortFromRubyEnvRead(content string, root string) int {
+	for _, re := range []*regexp.Regexp{rubyEnvFetchRegex, rubyEnvIndexRegex} {
+		for _, matches := range re.FindAllStringSubmatch(content, -1) {
+			defaultValue := ""
+			for _, value := range matches[2:] {
+				if value != "" {
+					defaultValue = value
+				}
+			}
+			if port := getPortFromRubyEnv(matches[1], defaultValue, root); port != -1 {
+				return port
+			}
+		}
+	}
+	return -1
+}
+
+// getPortFromRubyEnv returns the port set with the env var, either in the system or in the Dockerfile,
+// or the default value if the env var is not set
+func getPortFromRubyEnv(envVar string, defaultValue string, root string) int {
+	if ports := utils.GetValidPortsFromEnvs([]string{envVar}); len(ports) > 0 {
+		return ports[0]
+	}
+	if ports, err := utils.GetEnvVarPortValueFromDockerfile(root, []string{envVar}); err == nil && len(ports) > 0 {
+		return ports[0]
+	}
+	if port, err := utils.GetValidPort(defaultValue); err == nil {
+		return port
+	}
+	return -1
+}
+
+func getRubyApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	files, err := utils.GetCachedFilePathsFromRoot(componentPath, ctx)
+	if err != nil {
+		return []model.ApplicationFileInfo{}
+	}
+	return utils.GenerateApplicationFileFromFilters(files, componentPath, ".rb", ctx)
+}
@@ -0,0 +1,57 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+type SinatraDetector struct{}
+
+func (s SinatraDetector) GetSupportedFrameworks() []string {
+	return []string{"Sinatra"}
+}
+
+func (s SinatraDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return getRubyApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (s SinatraDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "sinatra") {
+		language.Frameworks = append(language.Frameworks, "Sinatra")
+	}
+}
+
+// DoPortsDetection searches for the port in the Procfiles, config/puma.rb, config.ru and,
+// as last option, inside the .rb files (e.g. set :port, 4567 or run! port: 4567)
+func (s SinatraDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	DoRubyPortsDetection(component, ctx)
+	if len(component.Ports) > 0 {
+		return
+	}
+
+	fileContents, err := utils.GetApplicationFileContents(s.GetApplicationFileInfos(component.Path, ctx))
+	if err != nil {
+		return
+	}
+	for _, fileContent := range fileContents {
+		ports := GetPortsFromSinatraFile(fileContent, component.Path)
+		if len(ports) > 0 {
+			component.Ports = ports
+			return
+		}
+	}
+}
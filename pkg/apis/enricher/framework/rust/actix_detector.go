@@ -0,0 +1,39 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type ActixDetector struct{}
+
+func (d ActixDetector) GetSupportedFrameworks() []string {
+	return []string{"Actix Web"}
+}
+
+func (d ActixDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return getRustApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (d ActixDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "actix-web") {
+		language.Frameworks = append(language.Frameworks, "Actix Web")
+	}
+}
+
+// DoPortsDetection does nothing, the enricher searches the .rs files for the bound addresses once for all frameworks
+func (d ActixDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+}
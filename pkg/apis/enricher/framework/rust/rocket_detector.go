@@ -0,0 +1,94 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+
+	"github.com/BurntSushi/toml"
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+type RocketDetector struct{}
+
+func (r RocketDetector) GetSupportedFrameworks() []string {
+	return []string{"Rocket"}
+}
+
+func (r RocketDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return getRustApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (r RocketDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "rocket") {
+		language.Frameworks = append(language.Frameworks, "Rocket")
+	}
+}
+
+// DoPortsDetection searches for the port in the ROCKET_PORT env var, the Dockerfile, Rocket.toml and the source code
+func (r RocketDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	// check if port is set as env var
+	ports := utils.GetValidPortsFromEnvs([]string{"ROCKET_PORT"})
+	if len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+
+	// check if port is set as env var inside a Dockerfile
+	ports, err := utils.GetEnvVarPortValueFromDockerfile(component.Path, []string{"ROCKET_PORT"})
+	if err == nil && len(ports) > 0 {
+		component.Ports = ports
+		return
+	}
+
+	// check if port is set inside Rocket.toml
+	if port := getPortFromRocketToml(component.Path); port != -1 {
+		component.Ports = []int{port}
+		return
+	}
+
+	// check if port is set inside the source code, e.g. rocket::Config { port: 8000, .. }
+	fileContents, err := utils.GetApplicationFileContents(r.GetApplicationFileInfos(component.Path, ctx))
+	if err != nil {
+		return
+	}
+	re := regexp.MustCompile(`(?:\(\s*"port"\s*,|\bport\s*:)\s*(\d+)`)
+	for _, fileContent := range fileContents {
+		if port := utils.FindPortSubmatch(re, fileContent, 1); port != -1 {
+			component.Ports = []int{port}
+			return
+		}
+	}
+}
+
+// getPortFromRocketToml returns the port of the first profile declaring one.
+// The global profile overrides all others and debug is the profile used by default.
+func getPortFromRocketToml(root string) int {
+	var profiles map[string]map[string]interface{}
+	if _, err := toml.DecodeFile(filepath.Join(root, "Rocket.toml"), &profiles); err != nil {
+		return -1
+	}
+	for _, profile := range []string{"global", "debug", "default", "release"} {
+		value, exists := profiles[profile]["port"]
+		if !exists {
+			continue
+		}
+		if port, ok := value.(int64); ok && utils.IsValidPort(int(port)) {
+			return int(port)
+		}
+	}
+	return -1
+}
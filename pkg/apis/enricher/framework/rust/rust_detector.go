@@ -0,0 +1,186 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/schema"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+var (
+	// e.g. .bind(("127.0.0.1", 8080)) or .bind(("0.0.0.0", port))
+	rustBindTupleRegex = regexp.MustCompile(`bind\(\s*\(\s*"[^"]*"\s*,\s*([\w]+)\s*\)`)
+	// e.g. TcpListener::bind("0.0.0.0:3000") or .bind("127.0.0.1:8080")
+	rustBindStringRegex = regexp.MustCompile(`bind\(\s*"[^"]*:(\d+)"`)
+	// e.g. SocketAddr::from(([127, 0, 0, 1], 3000)) or warp::serve(routes).run(([0, 0, 0, 0], port))
+	rustSocketAddrRegex = regexp.MustCompile(`\(\s*\[\s*\d+\s*,\s*\d+\s*,\s*\d+\s*,\s*\d+\s*\]\s*,\s*([\w]+)\s*\)`)
+	// e.g. TcpListener::bind(addr) or .bind(&addr)
+	rustBindVariableRegex = regexp.MustCompile(`bind\(\s*&?([A-Za-z_]\w*)\s*\)`)
+)
+
+// hasFramework uses the Cargo.toml to check for framework.
+// If the Cargo.toml belongs to a workspace, the dependencies of all its members are checked too.
+func hasFramework(configFile string, tag string) bool {
+	cargoToml, err := utils.GetCargoTomlSchemaFromFile(configFile)
+	if err != nil {
+		return false
+	}
+	if isTagInCargoDependencies(cargoToml, tag) {
+		return true
+	}
+	for _, member := range GetCargoWorkspaceMembers(filepath.Dir(configFile), cargoToml) {
+		memberCargoToml, err := utils.GetCargoTomlSchemaFromFile(filepath.Join(member, "Cargo.toml"))
+		if err != nil {
+			continue
+		}
+		if isTagInCargoDependencies(memberCargoToml, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTagInCargoDependencies(cargoToml schema.CargoToml, tag string) bool {
+	if _, exists := cargoToml.Dependencies[tag]; exists {
+		return true
+	}
+	_, exists := cargoToml.Workspace.Dependencies[tag]
+	return exists
+}
+
+// GetCargoWorkspaceMembers returns the directories of all members declared inside the workspace section
+// of a Cargo.toml. Members are expressed as globs relative to root and only directories with their own
+// Cargo.toml which are not excluded are returned.
+func GetCargoWorkspaceMembers(root string, cargoToml schema.CargoToml) []string {
+	var excluded []string
+	for _, exclude := range cargoToml.Workspace.Exclude {
+		excluded = append(excluded, filepath.Clean(filepath.Join(root, exclude)))
+	}
+	var members []string
+	for _, pattern := range cargoToml.Workspace.Members {
+		matches, err := filepath.Glob(filepath.Join(root, pattern))
+		if err != nil {
+			continue
+		}
+		for _, match := range matches {
+			match = filepath.Clean(match)
+			if utils.Contains(excluded, match) || utils.Contains(members, match) {
+				continue
+			}
+			if _, err := os.Stat(filepath.Join(match, "Cargo.toml")); err == nil {
+				members = append(members, match)
+			}
+		}
+	}
+	return members
+}
+
+// IsVirtualCargoWorkspace checks if the Cargo.toml only declares a workspace without a root package
+func IsVirtualCargoWorkspace(cargoToml schema.CargoToml) bool {
+	return cargoToml.Package.Name == "" && len(cargoToml.Workspace.Members) > 0
+}
+
+func getRustApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	files, err := utils.GetCachedFilePathsFromRoot(componentPath, ctx)
+	if err != nil {
+		return []model.ApplicationFileInfo{}
+	}
+	return utils.GenerateApplicationFileFromFilters(files, componentPath, ".rs", ctx)
+}
+
+// DoRustPortsDetection searches for the addresses servers are bound to inside the .rs files of a component.
+// It handles bind calls with a "host:port" string or a (host, port) tuple, socket addresses created from an
+// ip array and a port, and variables holding one of those values or the value of an env var.
+func DoRustPortsDetection(component *model.Component, ctx *context.Context) {
+	fileContents, err := utils.GetApplicationFileContents(getRustApplicationFileInfos(component.Path, ctx))
+	if err != nil {
+		return
+	}
+
+	for _, fileContent := range fileContents {
+		ports := GetPortsFromFileRust(fileContent, component.Path)
+		if len(ports) > 0 {
+			component.Ports = ports
+			return
+		}
+	}
+}
+
+// GetPortsFromFileRust returns all ports found inside the content of a .rs file
+func GetPortsFromFileRust(content string, root string) []int {
+	var ports []int
+	for _, matches := range rustBindStringRegex.FindAllStringSubmatch(content, -1) {
+		if port, err := utils.GetValidPort(matches[1]); err == nil {
+			ports = utils.AppendPortIfMissing(ports, port)
+		}
+	}
+	for _, re := range []*regexp.Regexp{rustBindTupleRegex, rustSocketAddrRegex, rustBindVariableRegex} {
+		for _, matchIndexes := range re.FindAllStringSubmatchIndex(content, -1) {
+			placeholder := content[matchIndexes[2]:matchIndexes[3]]
+			if port, err := utils.GetValidPort(placeholder); err == nil {
+				ports = utils.AppendPortIfMissing(ports, port)
+				continue
+			}
+			for _, port := range getPortsFromRustVariable(content[0:matchIndexes[0]], placeholder, root) {
+				ports = utils.AppendPortIfMissing(ports, port)
+			}
+		}
+	}
+	return ports
+}
+
+// getPortsFromRustVariable looks for the last let binding of variable declared before the port is used
+func getPortsFromRustVariable(contentBeforeMatch string, variable string, root string) []int {
+	re, err := regexp.Compile(`let\s+(?:mut\s+)?` + regexp.QuoteMeta(variable) + `\s*(?::[^=]+)?=\s*([^;]+);`)
+	if err != nil {
+		return []int{}
+	}
+	allMatches := re.FindAllStringSubmatch(contentBeforeMatch, -1)
+	if len(allMatches) == 0 {
+		return []int{}
+	}
+	value := allMatches[len(allMatches)-1][1]
+
+	// e.g. let port = 8080;
+	if port, err := utils.GetValidPort(value); err == nil {
+		return []int{port}
+	}
+	// e.g. let addr = "0.0.0.0:8080";
+	if port := utils.FindPortSubmatch(regexp.MustCompile(`^"[^"]*:(\d+)"`), value, 1); port != -1 {
+		return []int{port}
+	}
+	// e.g. let addr = SocketAddr::from(([0, 0, 0, 0], 8080));
+	if port := utils.FindPortSubmatch(rustSocketAddrRegex, value, 1); port != -1 {
+		return []int{port}
+	}
+	// e.g. let port = env::var("PORT").unwrap_or_else(|_| "8080".to_string());
+	envVar := utils.FindPotentialPortGroup(regexp.MustCompile(`env::var\(\s*"([^"]+)"\s*\)`), value, 1)
+	if envVar == "" {
+		return []int{}
+	}
+	var ports []int
+	if envPorts := utils.GetValidPortsFromEnvs([]string{envVar}); len(envPorts) > 0 {
+		ports = append(ports, envPorts...)
+	} else if envPorts, err := utils.GetEnvVarPortValueFromDockerfile(root, []string{envVar}); err == nil && len(envPorts) > 0 {
+		ports = append(ports, envPorts...)
+	}
+	if port := utils.FindPortSubmatch(regexp.MustCompile(`unwrap_or[^;]*?(\d+)`), value, 1); port != -1 {
+		ports = utils.AppendPortIfMissing(ports, port)
+	}
+	return ports
+}
@@ -0,0 +1,39 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type WarpDetector struct{}
+
+func (d WarpDetector) GetSupportedFrameworks() []string {
+	return []string{"Warp"}
+}
+
+func (d WarpDetector) GetApplicationFileInfos(componentPath string, ctx *context.Context) []model.ApplicationFileInfo {
+	return getRustApplicationFileInfos(componentPath, ctx)
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (d WarpDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "warp") {
+		language.Frameworks = append(language.Frameworks, "Warp")
+	}
+}
+
+// DoPortsDetection does nothing, the enricher searches the .rs files for the bound addresses once for all frameworks
+func (d WarpDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+}
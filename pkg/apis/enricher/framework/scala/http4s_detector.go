@@ -0,0 +1,36 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type Http4sDetector struct{}
+
+func (d Http4sDetector) GetSupportedFrameworks() []string {
+	return []string{"http4s"}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (d Http4sDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "\"org.http4s\"") {
+		language.Frameworks = append(language.Frameworks, "http4s")
+	}
+}
+
+// DoPortsDetection searches for the port in src/main/resources/application.conf and in bindHttp or withPort calls
+func (d Http4sDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	DoScalaPortsDetection(component, ctx)
+}
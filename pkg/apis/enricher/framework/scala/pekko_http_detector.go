@@ -0,0 +1,36 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type PekkoHttpDetector struct{}
+
+func (d PekkoHttpDetector) GetSupportedFrameworks() []string {
+	return []string{"Pekko HTTP"}
+}
+
+// DoFrameworkDetection uses a tag to check for the framework name
+func (d PekkoHttpDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "\"pekko-http\"") {
+		language.Frameworks = append(language.Frameworks, "Pekko HTTP")
+	}
+}
+
+// DoPortsDetection searches for the port in src/main/resources/application.conf and in bindAndHandle or newServerAt calls
+func (d PekkoHttpDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	DoScalaPortsDetection(component, ctx)
+}
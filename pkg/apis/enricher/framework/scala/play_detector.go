@@ -0,0 +1,36 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+)
+
+type PlayDetector struct{}
+
+func (d PlayDetector) GetSupportedFrameworks() []string {
+	return []string{"Play"}
+}
+
+// DoFrameworkDetection uses the Play sbt plugins enabled inside the build to check for the framework name
+func (d PlayDetector) DoFrameworkDetection(language *model.Language, config string) {
+	if hasFramework(config, "PlayScala") || hasFramework(config, "PlayJava") {
+		language.Frameworks = append(language.Frameworks, "Play")
+	}
+}
+
+// DoPortsDetection searches for the port in conf/application.conf
+func (d PlayDetector) DoPortsDetection(component *model.Component, ctx *context.Context) {
+	DoScalaPortsDetection(component, ctx)
+}
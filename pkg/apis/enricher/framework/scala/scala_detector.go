@@ -0,0 +1,157 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+var (
+	// e.g. Http().bindAndHandle(route, "localhost", 8080)
+	scalaBindAndHandleRegex = regexp.MustCompile(`bindAndHandle(?:Async|Sync)?\(\s*[^,]+,\s*(?:interface\s*=\s*)?"[^"]*"\s*,\s*(?:port\s*=\s*)?([\w.]+)`)
+	// e.g. Http().newServerAt("localhost", 8080).bind(route)
+	scalaNewServerAtRegex = regexp.MustCompile(`newServerAt\(\s*(?:interface\s*=\s*)?"[^"]*"\s*,\s*(?:port\s*=\s*)?([\w.]+)\s*\)`)
+	// e.g. BlazeServerBuilder[IO].bindHttp(8080, "0.0.0.0")
+	scalaBindHttpRegex = regexp.MustCompile(`bindHttp\(\s*(?:port\s*=\s*)?([\w.]+)`)
+	// e.g. EmberServerBuilder.default[IO].withPort(port"8080")
+	scalaWithPortRegex = regexp.MustCompile(`withPort\(\s*(?:port"(\d+)"|Port\.fromInt\(\s*([\w.]+)\s*\)(?:\.get)?)`)
+)
+
+// hasFramework uses the build.sbt and the build definition inside the project folder to check for framework
+func hasFramework(configFile string, tag string) bool {
+	root := filepath.Dir(configFile)
+	buildFiles := []string{
+		configFile,
+		filepath.Join(root, "project", "plugins.sbt"),
+		filepath.Join(root, "project", "Dependencies.scala"),
+	}
+	for _, buildFile := range buildFiles {
+		if hasTag, _ := utils.IsTagInFile(buildFile, tag); hasTag {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSbtProjectName returns the value of the first name setting inside build.sbt, e.g. name := "my-app"
+func GetSbtProjectName(root string) string {
+	bytes, err := os.ReadFile(filepath.Join(root, "build.sbt"))
+	if err != nil {
+		return ""
+	}
+	matches := regexp.MustCompile(`\bname\s*:=\s*"([^"]+)"`).FindStringSubmatch(string(bytes))
+	if len(matches) > 1 {
+		return matches[1]
+	}
+	return ""
+}
+
+// DoScalaPortsDetection searches for the port in conf/application.conf, src/main/resources/application.conf
+// and for the port used by bindAndHandle, newServerAt, bindHttp and withPort calls inside the .scala files
+func DoScalaPortsDetection(component *model.Component, ctx *context.Context) {
+	for _, confFile := range []string{filepath.Join("conf", "application.conf"), filepath.Join("src", "main", "resources", "application.conf")} {
+		bytes, err := os.ReadFile(filepath.Join(component.Path, confFile))
+		if err != nil {
+			continue
+		}
+		if port := getPortFromApplicationConf(string(bytes)); port != -1 {
+			component.Ports = []int{port}
+			return
+		}
+	}
+
+	files, err := utils.GetCachedFilePathsFromRoot(component.Path, ctx)
+	if err != nil {
+		return
+	}
+	fileContents, err := utils.GetApplicationFileContents(utils.GenerateApplicationFileFromFilters(files, component.Path, ".scala", ctx))
+	if err != nil {
+		return
+	}
+	for _, fileContent := range fileContents {
+		ports := GetPortsFromFileScala(fileContent)
+		if len(ports) > 0 {
+			component.Ports = ports
+			return
+		}
+	}
+}
+
+// getPortFromApplicationConf returns the play.server.http.port or the http.port set inside an application.conf file.
+// If the port can be overridden by an env var (e.g. http.port = ${?PORT}) its value is used when set.
+func getPortFromApplicationConf(content string) int {
+	envVarRegex := regexp.MustCompile(`(?m)^\s*(?:play\.server\.)?http\.port\s*[=:]\s*\$\{\??(\w+)\}`)
+	for _, matches := range envVarRegex.FindAllStringSubmatch(content, -1) {
+		if ports := utils.GetValidPortsFromEnvs([]string{matches[1]}); len(ports) > 0 {
+			return ports[0]
+		}
+	}
+	return utils.FindPortSubmatch(regexp.MustCompile(`(?m)^\s*(?:play\.server\.)?http\.port\s*[=:]\s*"?(\d+)"?`), content, 1)
+}
+
+// GetPortsFromFileScala returns the ports found inside the content of a .scala file.
+// If the port is a variable, it tries to find its value within the code.
+func GetPortsFromFileScala(content string) []int {
+	var ports []int
+	for _, re := range []*regexp.Regexp{scalaBindAndHandleRegex, scalaNewServerAtRegex, scalaBindHttpRegex, scalaWithPortRegex} {
+		for _, matchIndexes := range re.FindAllStringSubmatchIndex(content, -1) {
+			portPlaceholder := ""
+			for group := 1; group*2+1 < len(matchIndexes); group++ {
+				if matchIndexes[group*2] != -1 {
+					portPlaceholder = content[matchIndexes[group*2]:matchIndexes[group*2+1]]
+					break
+				}
+			}
+			port, err := utils.GetValidPort(portPlaceholder)
+			if err != nil {
+				port = getPortFromScalaVariable(content[0:matchIndexes[0]], portPlaceholder)
+			}
+			if port != -1 {
+				ports = utils.AppendPortIfMissing(ports, port)
+			}
+		}
+	}
+	return ports
+}
+
+// getPortFromScalaVariable looks for the last definition of variable declared before the port is used,
+// e.g. val port = 8080 or val port = sys.env.getOrElse("PORT", "8080").toInt
+func getPortFromScalaVariable(contentBeforeMatch string, variable string) int {
+	re, err := regexp.Compile(`va[lr]\s+` + regexp.QuoteMeta(variable) + `\s*(?::\s*\w+\s*)?=\s*([^\n]+)`)
+	if err != nil {
+		return -1
+	}
+	allMatches := re.FindAllStringSubmatch(contentBeforeMatch, -1)
+	if len(allMatches) == 0 {
+		return -1
+	}
+	value := allMatches[len(allMatches)-1][1]
+	if port := utils.FindPortSubmatch(regexp.MustCompile(`^(\d+)`), value, 1); port != -1 {
+		return port
+	}
+	envMatches := regexp.MustCompile(`(?:sys\.env|System\.getenv)[^"]*"(\w+)"(?:\s*,\s*"?(\d+)"?)?`).FindStringSubmatch(value)
+	if len(envMatches) > 1 {
+		if ports := utils.GetValidPortsFromEnvs([]string{envMatches[1]}); len(ports) > 0 {
+			return ports[0]
+		}
+		if port, err := utils.GetValidPort(envMatches[2]); err == nil {
+			return port
+		}
+	}
+	return -1
+}
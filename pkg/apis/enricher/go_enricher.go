@@ -26,7 +26,7 @@ type GoEnricher struct{}
 
 type GoFrameworkDetector interface {
 	GetSupportedFrameworks() []string
-	DoFrameworkDetection(language *model.Language, goMod *modfile.File)
+	DoFrameworkDetection(language *model.Language, goMod *modfile.File, ctx *context.Context)
 	DoPortsDetection(component *model.Component, ctx *context.Context)
 }
 
@@ -38,6 +38,15 @@ func getGoFrameworkDetectors() []GoFrameworkDetector {
 		&framework.FastHttpDetector{},
 		&framework.GoFiberDetector{},
 		&framework.MuxDetector{},
+		&framework.ChiDetector{},
+		&framework.IrisDetector{},
+		&framework.HertzDetector{},
+		&framework.GrpcDetector{},
+		&framework.KratosDetector{},
+		&framework.BuffaloDetector{},
+		// the order matters: net/http is only reported when none of the detectors above found a framework,
+		// so it must stay last
+		&framework.NetHttpDetector{},
 	}
 }
 
@@ -48,6 +57,13 @@ func (g GoEnricher) GetSupportedLanguages() []string {
 // DoEnrichLanguage runs DoFrameworkDetection with found go project files.
 // go project files: go.mod
 func (g GoEnricher) DoEnrichLanguage(language *model.Language, files *[]string) {
+	ctx := context.Background()
+	g.DoEnrichLanguageWithContext(language, files, &ctx)
+}
+
+// DoEnrichLanguageWithContext runs DoFrameworkDetection with found go project files, sharing the files of the
+// module cached inside ctx.
+func (g GoEnricher) DoEnrichLanguageWithContext(language *model.Language, files *[]string, ctx *context.Context) {
 	goModPath := utils.GetFile(files, "go.mod")
 
 	if goModPath != "" {
@@ -58,13 +74,20 @@ func (g GoEnricher) DoEnrichLanguage(language *model.Language, files *[]string)
 		if goModFile.Go != nil {
 			language.Tools = []string{goModFile.Go.Version}
 		}
-		detectGoFrameworks(language, goModFile)
+		detectGoFrameworks(language, goModFile, ctx)
 	}
 }
 
 // DoEnrichComponent checks for the port number using a Dockerfile, Compose file, or Source strategy
 func (g GoEnricher) DoEnrichComponent(component *model.Component, settings model.DetectionSettings, ctx *context.Context) {
 	projectName := GetDefaultProjectName(component.Path)
+	if module, found := framework.GetGoModuleOfDir(component.Path); found {
+		// a main package of a module with many main packages is named after its folder
+		if dir, err := filepath.Abs(component.Path); err == nil && dir == module.Path {
+			projectName = module.Name
+		}
+		component.Dependencies = framework.GetGoModuleDependencies(module)
+	}
 	component.Name = projectName
 
 	for _, algorithm := range settings.PortDetectionStrategy {
@@ -82,13 +105,7 @@ func (g GoEnricher) DoEnrichComponent(component *model.Component, settings model
 			}
 		case model.Source:
 			{
-				for _, detector := range getGoFrameworkDetectors() {
-					for _, framework := range component.Languages[0].Frameworks {
-						if utils.Contains(detector.GetSupportedFrameworks(), framework) {
-							detector.DoPortsDetection(component, ctx)
-						}
-					}
-				}
+				detectGoPorts(component, ctx)
 				if len(component.Ports) == 0 {
 					framework.DoGoPortsDetection(component, ctx)
 				}
@@ -103,6 +120,43 @@ func (g GoEnricher) DoEnrichComponent(component *model.Component, settings model
 	}
 }
 
+// detectGoPorts runs the ports detection of all the frameworks of component and merges the ports they find,
+// e.g. the HTTP port of a Chi router and the port of a gRPC server started by the same application
+func detectGoPorts(component *model.Component, ctx *context.Context) {
+	var ports []int
+	for _, detector := range getGoFrameworkDetectors() {
+		for _, framework := range component.Languages[0].Frameworks {
+			if !utils.Contains(detector.GetSupportedFrameworks(), framework) {
+				continue
+			}
+			component.Ports = []int{}
+			detector.DoPortsDetection(component, ctx)
+			for _, port := range component.Ports {
+				ports = utils.AppendPortIfMissing(ports, port)
+			}
+			break
+		}
+	}
+	component.Ports = ports
+}
+
+// SplitComponent returns a component for each main package of a module with many main packages,
+// e.g. cmd/api/main.go and cmd/worker/main.go
+func (g GoEnricher) SplitComponent(component model.Component, ctx *context.Context) []model.Component {
+	mainDirs := framework.GetGoMainPackages(component.Path, ctx)
+	if len(mainDirs) < 2 {
+		return []model.Component{component}
+	}
+	var components []model.Component
+	for _, mainDir := range mainDirs {
+		components = append(components, model.Component{
+			Path:      mainDir + string(os.PathSeparator),
+			Languages: append([]model.Language{}, component.Languages...),
+		})
+	}
+	return components
+}
+
 func (g GoEnricher) IsConfigValidForComponentDetection(language string, config string) bool {
 	return IsConfigurationValidForLanguage(language, config)
 }
@@ -115,8 +169,8 @@ func getGoModFile(filePath string) (*modfile.File, error) {
 	return modfile.Parse(filePath, b, nil)
 }
 
-func detectGoFrameworks(language *model.Language, configFile *modfile.File) {
+func detectGoFrameworks(language *model.Language, configFile *modfile.File, ctx *context.Context) {
 	for _, detector := range getGoFrameworkDetectors() {
-		detector.DoFrameworkDetection(language, configFile)
+		detector.DoFrameworkDetection(language, configFile, ctx)
 	}
 }
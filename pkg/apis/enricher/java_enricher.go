@@ -21,6 +21,7 @@ import (
 	framework "github.com/devfile/alizer/pkg/apis/enricher/framework/java"
 	"github.com/devfile/alizer/pkg/apis/model"
 	"github.com/devfile/alizer/pkg/utils"
+	langfile "github.com/devfile/alizer/pkg/utils/langfiles"
 )
 
 type JavaEnricher struct{}
@@ -37,41 +38,89 @@ func getJavaFrameworkDetectors() []FrameworkDetectorWithConfigFile {
 		&framework.JakartaEEDetector{},
 		&framework.WebSphereDetector{},
 		&framework.WebLogicDetector{},
+		&framework.KtorDetector{},
 	}
 }
 
 func (j JavaEnricher) GetSupportedLanguages() []string {
-	return []string{"java"}
+	return []string{"java", "kotlin"}
 }
 
 // DoEnrichLanguage runs DoFrameworkDetection with found java project files.
-// java project files: build.gradle, pom.xml, build.xml
+// java project files: build.gradle, build.gradle.kts, pom.xml, build.xml
 func (j JavaEnricher) DoEnrichLanguage(language *model.Language, files *[]string) {
 	gradle := utils.GetFile(files, "build.gradle")
+	if gradle == "" {
+		gradle = utils.GetFile(files, "build.gradle.kts")
+	}
 	maven := utils.GetFile(files, "pom.xml")
 	ant := utils.GetFile(files, "build.xml")
 
 	if gradle != "" {
 		language.Tools = []string{"Gradle"}
+		setJVMLanguage(language, gradle, files)
 		detectJavaFrameworks(language, gradle)
 	} else if maven != "" {
 		language.Tools = []string{"Maven"}
+		setJVMLanguage(language, maven, files)
 		detectJavaFrameworks(language, maven)
 	} else if ant != "" {
 		language.Tools = []string{"Ant"}
 	}
 }
 
+// setJVMLanguage sets Kotlin as language if the kotlin plugin is applied inside the build file, Java otherwise.
+// The language is kept when files contain sources written in it, e.g. the Java sources of a project mixing
+// Java and Kotlin, so that each language is reported once.
+func setJVMLanguage(language *model.Language, configFile string, files *[]string) {
+	targetLanguage := "Java"
+	if isKotlinProject(configFile) {
+		targetLanguage = "Kotlin"
+	}
+	if strings.EqualFold(language.Name, targetLanguage) || hasJVMSources(files, language.Name) {
+		return
+	}
+	lang, err := langfile.Get().GetLanguageByName(targetLanguage)
+	if err == nil {
+		language.Name = lang.Name
+		language.Aliases = lang.Aliases
+	}
+}
+
+// hasJVMSources checks if any of files is a source file of the Java or Kotlin language
+func hasJVMSources(files *[]string, language string) bool {
+	extension := ".java"
+	if strings.EqualFold(language, "Kotlin") {
+		extension = ".kt"
+	}
+	for _, file := range *files {
+		if filepath.Ext(file) == extension {
+			return true
+		}
+	}
+	return false
+}
+
+// isKotlinProject checks if the kotlin plugin or the kotlin stdlib is declared inside the build file
+func isKotlinProject(configFile string) bool {
+	if hasKotlin, _ := utils.IsTagInFile(configFile, "org.jetbrains.kotlin"); hasKotlin {
+		return true
+	}
+	hasKotlin, _ := utils.IsTagInFile(configFile, "kotlin(\"jvm\")")
+	return hasKotlin
+}
+
 // DoEnrichComponent checks for the port number using a Dockerfile, Compose file, or Source strategy
 func (j JavaEnricher) DoEnrichComponent(component *model.Component, settings model.DetectionSettings, ctx *context.Context) {
 	projectName := getProjectNameMaven(component.Path)
 	if projectName == "" {
-		projectName = getProjectNameGradle(component.Path)
+		projectName = getProjectNameGradle(component.Path, ctx)
 	}
 	if projectName == "" {
 		projectName = GetDefaultProjectName(component.Path)
 	}
 	component.Name = projectName
+	enrichMavenModuleComponent(component, ctx)
 
 	for _, algorithm := range settings.PortDetectionStrategy {
 		var ports []int
@@ -106,8 +155,20 @@ func (j JavaEnricher) DoEnrichComponent(component *model.Component, settings mod
 	}
 }
 
-func getProjectNameGradle(root string) string {
-	settingsGradlePath := filepath.Join(root, "settings.gradle")
+func getProjectNameGradle(root string, ctx *context.Context) string {
+	if subproject, found := framework.GetGradleSubproject(root, ctx); found {
+		return subproject.Name
+	}
+	for _, settingsFile := range []string{"settings.gradle", "settings.gradle.kts"} {
+		if projectName := getProjectNameFromGradleSettings(filepath.Join(root, settingsFile)); projectName != "" {
+			return projectName
+		}
+	}
+	return ""
+}
+
+// getProjectNameFromGradleSettings returns the rootProject.name set inside a settings.gradle or settings.gradle.kts file
+func getProjectNameFromGradleSettings(settingsGradlePath string) string {
 	if _, err := os.Stat(settingsGradlePath); err == nil {
 		re := regexp.MustCompile(`rootProject.name\s*=\s*(.*)`)
 		cleanSettingsGradlePath := filepath.Clean(settingsGradlePath)
@@ -138,10 +199,34 @@ func getProjectNameMaven(root string) string {
 }
 
 func (j JavaEnricher) IsConfigValidForComponentDetection(language string, config string) bool {
-	return IsConfigurationValidForLanguage(language, config) && !isParentModuleMaven(config)
+	ctx := context.Background()
+	return j.IsConfigValidForComponentDetectionWithContext(language, config, &ctx)
+}
+
+// IsConfigValidForComponentDetectionWithContext checks if the config is valid for component detection, caching the
+// settings files of the Gradle multi-project builds inside ctx
+func (j JavaEnricher) IsConfigValidForComponentDetectionWithContext(language string, config string, ctx *context.Context) bool {
+	return IsConfigurationValidForLanguage(language, config) && !isParentModuleMaven(config) && !isNotDeployableProjectGradle(config, ctx)
+}
+
+// isNotDeployableProjectGradle checks if configPath is the build file of the root of a Gradle multi-project build
+// or of one of its subprojects which does not apply an application plugin
+func isNotDeployableProjectGradle(configPath string, ctx *context.Context) bool {
+	dir, file := filepath.Split(configPath)
+	if file != "build.gradle" && file != "build.gradle.kts" {
+		return false
+	}
+	if framework.IsGradleMultiProjectRoot(dir, ctx) {
+		return true
+	}
+	if _, found := framework.GetGradleSubproject(dir, ctx); found {
+		return !framework.IsGradleApplicationProject(configPath)
+	}
+	return false
 }
 
-// isParentModuleMaven checks if configPath is a parent pom.xml
+// isParentModuleMaven checks if configPath is a parent pom.xml, declaring modules in its main build or in its
+// profiles, or a pom.xml with pom packaging (e.g. a BOM) which does not build any code
 func isParentModuleMaven(configPath string) bool {
 	_, file := filepath.Split(configPath)
 	if !strings.EqualFold(file, "pom.xml") {
@@ -149,7 +234,21 @@ func isParentModuleMaven(configPath string) bool {
 	}
 
 	pomContent, _ := utils.GetPomFileContent(configPath)
-	return pomContent.Modules.Module != ""
+	return len(framework.GetMavenModuleNames(pomContent)) > 0 || pomContent.Packaging == "pom"
+}
+
+// enrichMavenModuleComponent sets the parent, the kind and the dependencies on other modules of a component
+// belonging to a Maven multi-module build
+func enrichMavenModuleComponent(component *model.Component, ctx *context.Context) {
+	module, found := framework.GetMavenReactorModule(component.Path, ctx)
+	if !found {
+		return
+	}
+	if module.Parent != nil {
+		component.Parent = module.Parent.ArtifactId
+	}
+	component.Library = !framework.IsMavenModuleDeployable(module)
+	component.Dependencies = framework.GetMavenModuleDependencies(module)
 }
 
 func detectJavaFrameworks(language *model.Language, configFile string) {
@@ -15,6 +15,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 
 	framework "github.com/devfile/alizer/pkg/apis/enricher/framework/javascript/nodejs"
 	"github.com/devfile/alizer/pkg/apis/model"
@@ -26,12 +27,24 @@ type JavaScriptEnricher struct{}
 
 func getJavaScriptFrameworkDetectors() []FrameworkDetectorWithConfigFile {
 	return []FrameworkDetectorWithConfigFile{
+		&framework.AdonisJsDetector{},
 		&framework.AngularDetector{},
+		&framework.AstroDetector{},
 		&framework.ExpressDetector{},
+		&framework.FastifyDetector{},
+		&framework.GatsbyDetector{},
+		&framework.HapiDetector{},
+		&framework.KoaDetector{},
+		&framework.NestJsDetector{},
 		&framework.NextDetector{},
 		&framework.NuxtDetector{},
+		&framework.QwikDetector{},
 		&framework.ReactJsDetector{},
+		&framework.RemixDetector{},
+		&framework.SolidStartDetector{},
 		&framework.SvelteDetector{},
+		&framework.SvelteKitDetector{},
+		&framework.ViteDetector{},
 		&framework.VueDetector{},
 	}
 }
@@ -41,24 +54,61 @@ func (j JavaScriptEnricher) GetSupportedLanguages() []string {
 }
 
 // DoEnrichLanguage runs DoFrameworkDetection with found javascript project files.
-// javascript project files: package.json
+// javascript project files: package.json, project.json (Nx)
 func (j JavaScriptEnricher) DoEnrichLanguage(language *model.Language, files *[]string) {
 	packageJson := utils.GetFile(files, "package.json")
+	projectJson := utils.GetFile(files, "project.json")
 
 	if packageJson != "" {
-		language.Tools = []string{"NodeJs", "Node.js"}
+		language.Tools = getJavaScriptTools(getJavaScriptToolsRoot(filepath.Dir(packageJson)))
 		var targetLanguage string
 		if utils.IsTagInPackageJsonFile(packageJson, "typescript") || utils.IsTagInPackageJsonFile(packageJson, "tslib") {
 			targetLanguage = "TypeScript"
 		} else {
 			targetLanguage = "JavaScript"
 		}
-		lang, err := langfile.Get().GetLanguageByName(targetLanguage)
-		if err == nil {
-			language.Name = lang.Name
-			language.Aliases = lang.Aliases
-		}
+		setJavaScriptLanguage(language, targetLanguage)
 		detectJavaScriptFrameworks(language, packageJson)
+		addWorkspaceTool(language, filepath.Dir(packageJson))
+	} else if projectJson != "" {
+		// projects of an Nx integrated monorepo only have a project.json, the dependencies are declared at the root
+		dir := filepath.Dir(projectJson)
+		language.Tools = getJavaScriptTools(getJavaScriptToolsRoot(dir))
+		targetLanguage := "JavaScript"
+		if isAnyFileInRoot(dir, "tsconfig.json", "tsconfig.app.json", "tsconfig.lib.json") {
+			targetLanguage = "TypeScript"
+		}
+		setJavaScriptLanguage(language, targetLanguage)
+		language.Frameworks = append(language.Frameworks, framework.GetFrameworksFromNxExecutors(dir)...)
+		addWorkspaceTool(language, dir)
+	}
+}
+
+func setJavaScriptLanguage(language *model.Language, targetLanguage string) {
+	lang, err := langfile.Get().GetLanguageByName(targetLanguage)
+	if err == nil {
+		language.Name = lang.Name
+		language.Aliases = lang.Aliases
+	}
+}
+
+// getJavaScriptToolsRoot returns the root of the workspace dir belongs to, where the runtime, the packageManager
+// field and the lockfile live, or dir itself if it is not part of a workspace
+func getJavaScriptToolsRoot(dir string) string {
+	if root := framework.GetWorkspaceRoot(dir); root != "" {
+		return root
+	}
+	return dir
+}
+
+// addWorkspaceTool adds the monorepo tool (Nx, Turborepo or Lerna) of the workspace dir belongs to
+func addWorkspaceTool(language *model.Language, dir string) {
+	root := framework.GetWorkspaceRoot(dir)
+	if root == "" {
+		return
+	}
+	if tool := framework.GetWorkspaceTool(root); tool != "" && !utils.Contains(language.Tools, tool) {
+		language.Tools = append(language.Tools, tool)
 	}
 }
 
@@ -72,10 +122,14 @@ func (j JavaScriptEnricher) DoEnrichComponent(component *model.Component, settin
 			projectName = packageJson.Name
 		}
 	}
+	if projectJson, err := utils.GetNxProjectJsonSchemaFromFile(filepath.Join(component.Path, "project.json")); err == nil && projectJson.Name != "" {
+		projectName = projectJson.Name
+	}
 	if projectName == "" {
 		projectName = GetDefaultProjectName(component.Path)
 	}
 	component.Name = projectName
+	enrichWorkspaceComponent(component, ctx)
 
 	for _, algorithm := range settings.PortDetectionStrategy {
 		var ports []int
@@ -92,13 +146,11 @@ func (j JavaScriptEnricher) DoEnrichComponent(component *model.Component, settin
 			}
 		case model.Source:
 			{
-				for _, detector := range getJavaScriptFrameworkDetectors() {
-					for _, framework := range component.Languages[0].Frameworks {
-						if utils.Contains(detector.GetSupportedFrameworks(), framework) {
-							detector.DoPortsDetection(component, ctx)
-						}
-					}
+				if port := framework.GetPortFromNxProjectJson(component.Path); port != -1 {
+					component.Ports = []int{port}
+					return
 				}
+				detectJavaScriptPorts(component, ctx)
 			}
 		}
 		if len(ports) > 0 {
@@ -110,8 +162,66 @@ func (j JavaScriptEnricher) DoEnrichComponent(component *model.Component, settin
 	}
 }
 
+// detectJavaScriptPorts runs the ports detection of all the frameworks of component. Only if none of them
+// found a port, the default port of the first framework having one is used, whatever the order of the detectors.
+func detectJavaScriptPorts(component *model.Component, ctx *context.Context) {
+	var detectors []FrameworkDetectorWithConfigFile
+	for _, detector := range getJavaScriptFrameworkDetectors() {
+		for _, framework := range component.Languages[0].Frameworks {
+			if utils.Contains(detector.GetSupportedFrameworks(), framework) {
+				detectors = append(detectors, detector)
+				break
+			}
+		}
+	}
+
+	for _, detector := range detectors {
+		detector.DoPortsDetection(component, ctx)
+	}
+	if len(component.Ports) > 0 {
+		return
+	}
+
+	for _, detector := range detectors {
+		if defaultPortDetector, ok := detector.(FrameworkDetectorWithDefaultPort); ok {
+			component.Ports = []int{defaultPortDetector.GetDefaultPort(component.Path)}
+			return
+		}
+	}
+}
+
+// IsConfigValidForComponentDetection checks if the config is valid for component detection.
+// The root of a monorepo or of a npm, Yarn or pnpm workspace only groups its projects, which are detected as components on their own, and
+// a project.json is only valid inside an Nx workspace if there is no package.json next to it.
 func (j JavaScriptEnricher) IsConfigValidForComponentDetection(language string, config string) bool {
-	return IsConfigurationValidForLanguage(language, config)
+	if !IsConfigurationValidForLanguage(language, config) {
+		return false
+	}
+	dir := filepath.Dir(config)
+	if filepath.Base(config) == "project.json" {
+		return framework.IsNxProject(dir) && !isAnyFileInRoot(dir, "package.json")
+	}
+	return !framework.IsWorkspaceAggregator(dir)
+}
+
+// enrichWorkspaceComponent marks the libraries of a monorepo and sets the projects the component depends on
+func enrichWorkspaceComponent(component *model.Component, ctx *context.Context) {
+	dir, err := filepath.Abs(component.Path)
+	if err != nil {
+		return
+	}
+	root := framework.GetWorkspaceRoot(dir)
+	if root == "" || root == dir {
+		return
+	}
+	projects := framework.GetWorkspaceProjects(root, ctx)
+	for _, project := range projects {
+		if project.Path == dir {
+			component.Library = framework.IsWorkspaceLibrary(dir)
+			component.Dependencies = framework.GetWorkspaceDependencies(root, dir, projects, ctx)
+			return
+		}
+	}
 }
 
 func detectJavaScriptFrameworks(language *model.Language, configFile string) {
@@ -119,3 +229,65 @@ func detectJavaScriptFrameworks(language *model.Language, configFile string) {
 		detector.DoFrameworkDetection(language, configFile)
 	}
 }
+
+// getJavaScriptTools returns the runtime and the package manager used by the project inside root.
+// The runtime is Deno (deno.json), Bun (bunfig.toml) or Node.js. The package manager is taken from the
+// packageManager field of the package.json or, if missing, from the lockfile. npm is used by default,
+// except for Deno projects which manage their dependencies without a package manager.
+func getJavaScriptTools(root string) []string {
+	var tools []string
+	if isAnyFileInRoot(root, "deno.json", "deno.jsonc") {
+		tools = []string{"Deno"}
+	} else if isAnyFileInRoot(root, "bunfig.toml") {
+		tools = []string{"Bun"}
+	} else {
+		tools = []string{"NodeJs", "Node.js"}
+	}
+
+	packageManager := getJavaScriptPackageManager(root)
+	if packageManager != "" && !utils.Contains(tools, packageManager) {
+		tools = append(tools, packageManager)
+	}
+	return tools
+}
+
+// getJavaScriptPackageManager returns npm, Yarn (classic), Yarn Berry (v2+), pnpm or Bun
+func getJavaScriptPackageManager(root string) string {
+	packageJson, _ := utils.GetPackageJsonSchemaFromFile(filepath.Join(root, "package.json"))
+	if name, version, found := strings.Cut(packageJson.PackageManager, "@"); found {
+		switch name {
+		case "npm":
+			return "npm"
+		case "yarn":
+			if strings.HasPrefix(version, "1.") {
+				return "Yarn"
+			}
+			return "Yarn Berry"
+		case "pnpm":
+			return "pnpm"
+		case "bun":
+			return "Bun"
+		}
+	}
+
+	switch {
+	case isAnyFileInRoot(root, "pnpm-lock.yaml"):
+		return "pnpm"
+	case isAnyFileInRoot(root, "bun.lockb", "bun.lock"):
+		return "Bun"
+	case isAnyFileInRoot(root, "yarn.lock"):
+		if isAnyFileInRoot(root, ".yarnrc.yml") || isYarnBerryLockfile(filepath.Join(root, "yarn.lock")) {
+			return "Yarn Berry"
+		}
+		return "Yarn"
+	case isAnyFileInRoot(root, "deno.json", "deno.jsonc") && !isAnyFileInRoot(root, "package-lock.json"):
+		return ""
+	}
+	return "npm"
+}
+
+// isYarnBerryLockfile checks if the yarn.lock has been generated by Yarn v2+, which adds a __metadata entry
+func isYarnBerryLockfile(yarnLockPath string) bool {
+	hasMetadata, _ := utils.IsTagInFile(yarnLockPath, "__metadata:")
+	return hasMetadata
+}
@@ -13,6 +13,10 @@ package enricher
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 
 	framework "github.com/devfile/alizer/pkg/apis/enricher/framework/python"
 	"github.com/devfile/alizer/pkg/apis/model"
@@ -25,6 +29,12 @@ func getPythonFrameworkDetectors() []FrameworkDetectorWithoutConfigFile {
 	return []FrameworkDetectorWithoutConfigFile{
 		&framework.DjangoDetector{},
 		&framework.FlaskDetector{},
+		&framework.FastAPIDetector{},
+		&framework.StarletteDetector{},
+		&framework.TornadoDetector{},
+		&framework.AioHttpDetector{},
+		&framework.SanicDetector{},
+		&framework.QuartDetector{},
 	}
 }
 
@@ -34,14 +44,24 @@ func (p PythonEnricher) GetSupportedLanguages() []string {
 
 // DoEnrichLanguage runs DoFrameworkDetection with files.
 // No specific file is targeted, will use everything in files.
+// The packaging tools are detected from the folder of the first python configuration file found.
 func (p PythonEnricher) DoEnrichLanguage(language *model.Language, files *[]string) {
 	language.Tools = []string{}
+	for _, file := range *files {
+		if utils.Contains(getPythonConfigFiles(), filepath.Base(file)) {
+			language.Tools = getPythonTools(filepath.Dir(file))
+			break
+		}
+	}
 	detectPythonFrameworks(language, files)
 }
 
 // DoEnrichComponent checks for the port number using a Dockerfile, Compose file, or Source strategy
 func (p PythonEnricher) DoEnrichComponent(component *model.Component, settings model.DetectionSettings, ctx *context.Context) {
-	projectName := GetDefaultProjectName(component.Path)
+	projectName := getProjectNamePython(component.Path)
+	if projectName == "" {
+		projectName = GetDefaultProjectName(component.Path)
+	}
 	component.Name = projectName
 
 	for _, algorithm := range settings.PortDetectionStrategy {
@@ -59,6 +79,10 @@ func (p PythonEnricher) DoEnrichComponent(component *model.Component, settings m
 			}
 		case model.Source:
 			{
+				framework.DoWSGIServerPortsDetection(component)
+				if len(component.Ports) > 0 {
+					break
+				}
 				for _, detector := range getPythonFrameworkDetectors() {
 					for _, framework := range component.Languages[0].Frameworks {
 						if utils.Contains(detector.GetSupportedFrameworks(), framework) {
@@ -81,8 +105,94 @@ func (p PythonEnricher) IsConfigValidForComponentDetection(language string, conf
 	return IsConfigurationValidForLanguage(language, config)
 }
 
+// getProjectNamePython returns the name declared in pyproject.toml ([project] or [tool.poetry]), setup.cfg or setup.py.
+// For Django projects the top-level package of DJANGO_SETTINGS_MODULE is used.
+func getProjectNamePython(root string) string {
+	if pyProjectToml, err := utils.GetPyProjectTomlSchemaFromFile(filepath.Join(root, "pyproject.toml")); err == nil {
+		if pyProjectToml.Project.Name != "" {
+			return pyProjectToml.Project.Name
+		}
+		if name, ok := pyProjectToml.Tool.Poetry["name"].(string); ok && name != "" {
+			return name
+		}
+	}
+	if projectName := getProjectNameFromPythonFile(filepath.Join(root, "setup.cfg"), `(?m)^\[metadata\][^\[]*?^\s*name\s*=\s*([^\s#;]+)`); projectName != "" {
+		return projectName
+	}
+	if projectName := getProjectNameFromPythonFile(filepath.Join(root, "setup.py"), `\bname\s*=\s*["']([^"']+)["']`); projectName != "" {
+		return projectName
+	}
+	for _, djangoFile := range []string{"manage.py", "wsgi.py", "asgi.py"} {
+		if projectName := getProjectNameFromPythonFile(filepath.Join(root, djangoFile), `DJANGO_SETTINGS_MODULE["']\s*,\s*["'](\w+)\.`); projectName != "" {
+			return projectName
+		}
+	}
+	return ""
+}
+
+// getProjectNameFromPythonFile returns the first group matched by pattern inside the file
+func getProjectNameFromPythonFile(path string, pattern string) string {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return ""
+	}
+	re := regexp.MustCompile(pattern)
+	if match := re.FindStringSubmatch(string(bytes)); len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
+
 func detectPythonFrameworks(language *model.Language, files *[]string) {
 	for _, detector := range getPythonFrameworkDetectors() {
 		detector.DoFrameworkDetection(language, files)
 	}
 }
+
+func getPythonConfigFiles() []string {
+	return []string{"pyproject.toml", "Pipfile", "requirements.txt", "setup.py", "setup.cfg", "environment.yml", "environment.yaml"}
+}
+
+// getPythonTools returns the packaging tools used by the python project inside root.
+// pip: requirements*.txt, Poetry/PDM/Hatch/uv: pyproject.toml build-system, tool sections and lockfiles,
+// Pipenv: Pipfile, Conda: environment.yml, setuptools: setup.py/setup.cfg or setuptools build-system
+func getPythonTools(root string) []string {
+	tools := []string{}
+	pyProjectToml, _ := utils.GetPyProjectTomlSchemaFromFile(filepath.Join(root, "pyproject.toml"))
+	buildBackend := pyProjectToml.BuildSystem.BuildBackend
+
+	if requirements, _ := filepath.Glob(filepath.Join(root, "requirements*.txt")); len(requirements) > 0 {
+		tools = append(tools, "pip")
+	}
+	if isAnyFileInRoot(root, "poetry.lock") || pyProjectToml.Tool.Poetry != nil || strings.HasPrefix(buildBackend, "poetry") {
+		tools = append(tools, "Poetry")
+	}
+	if isAnyFileInRoot(root, "pdm.lock") || pyProjectToml.Tool.Pdm != nil || strings.HasPrefix(buildBackend, "pdm") {
+		tools = append(tools, "PDM")
+	}
+	if pyProjectToml.Tool.Hatch != nil || strings.HasPrefix(buildBackend, "hatchling") {
+		tools = append(tools, "Hatch")
+	}
+	if isAnyFileInRoot(root, "uv.lock") || pyProjectToml.Tool.Uv != nil {
+		tools = append(tools, "uv")
+	}
+	if isAnyFileInRoot(root, "Pipfile", "Pipfile.lock") {
+		tools = append(tools, "Pipenv")
+	}
+	if isAnyFileInRoot(root, "environment.yml", "environment.yaml") {
+		tools = append(tools, "Conda")
+	}
+	if isAnyFileInRoot(root, "setup.py", "setup.cfg") || strings.HasPrefix(buildBackend, "setuptools") {
+		tools = append(tools, "setuptools")
+	}
+	return tools
+}
+
+func isAnyFileInRoot(root string, filenames ...string) bool {
+	for _, filename := range filenames {
+		if _, err := os.Stat(filepath.Join(root, filename)); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"path/filepath"
+
+	framework "github.com/devfile/alizer/pkg/apis/enricher/framework/ruby"
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+type RubyEnricher struct{}
+
+func getRubyFrameworkDetectors() []FrameworkDetectorWithConfigFile {
+	return []FrameworkDetectorWithConfigFile{
+		&framework.RailsDetector{},
+		&framework.SinatraDetector{},
+		&framework.HanamiDetector{},
+	}
+}
+
+func (r RubyEnricher) GetSupportedLanguages() []string {
+	return []string{"ruby"}
+}
+
+// DoEnrichLanguage runs DoFrameworkDetection with found ruby project files.
+// ruby project files: Gemfile, *.gemspec
+func (r RubyEnricher) DoEnrichLanguage(language *model.Language, files *[]string) {
+	configFile := utils.GetFile(files, "Gemfile")
+	if configFile == "" {
+		gemspecs := utils.GetFilesByRegex(files, ".*\\.gemspec")
+		if len(gemspecs) > 0 {
+			configFile = gemspecs[0]
+		}
+	}
+
+	if configFile != "" {
+		// the Gemfile is not part of files when only the .gemspec is analyzed, so it is also searched next to it
+		if utils.GetFile(files, "Gemfile") != "" || utils.GetFile(files, "Gemfile.lock") != "" || isAnyFileInRoot(filepath.Dir(configFile), "Gemfile", "Gemfile.lock") {
+			language.Tools = []string{"Bundler"}
+		}
+		detectRubyFrameworks(language, configFile)
+	}
+}
+
+// DoEnrichComponent checks for the port number using a Dockerfile, Compose file, or Source strategy
+func (r RubyEnricher) DoEnrichComponent(component *model.Component, settings model.DetectionSettings, ctx *context.Context) {
+	projectName := framework.GetRubyApplicationName(component.Path)
+	if projectName == "" {
+		projectName = GetDefaultProjectName(component.Path)
+	}
+	component.Name = projectName
+
+	for _, algorithm := range settings.PortDetectionStrategy {
+		var ports []int
+		switch algorithm {
+		case model.DockerFile:
+			{
+				ports = GetPortsFromDockerFile(component.Path)
+				break
+			}
+		case model.Compose:
+			{
+				ports = GetPortsFromDockerComposeFile(component.Path, settings)
+				break
+			}
+		case model.Source:
+			{
+				for _, detector := range getRubyFrameworkDetectors() {
+					for _, framework := range component.Languages[0].Frameworks {
+						if utils.Contains(detector.GetSupportedFrameworks(), framework) {
+							detector.DoPortsDetection(component, ctx)
+						}
+					}
+				}
+				if len(component.Ports) == 0 {
+					framework.DoRubyPortsDetection(component, ctx)
+				}
+			}
+		}
+		if len(ports) > 0 {
+			component.Ports = ports
+		}
+		if len(component.Ports) > 0 {
+			return
+		}
+	}
+}
+
+func (r RubyEnricher) IsConfigValidForComponentDetection(language string, config string) bool {
+	return IsConfigurationValidForLanguage(language, config)
+}
+
+func detectRubyFrameworks(language *model.Language, configFile string) {
+	for _, detector := range getRubyFrameworkDetectors() {
+		detector.DoFrameworkDetection(language, configFile)
+	}
+}
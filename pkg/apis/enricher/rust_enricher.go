@@ -0,0 +1,117 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+	"path/filepath"
+
+	framework "github.com/devfile/alizer/pkg/apis/enricher/framework/rust"
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+type RustEnricher struct{}
+
+func getRustFrameworkDetectors() []FrameworkDetectorWithConfigFile {
+	return []FrameworkDetectorWithConfigFile{
+		&framework.ActixDetector{},
+		&framework.AxumDetector{},
+		&framework.RocketDetector{},
+		&framework.WarpDetector{},
+		&framework.TokioDetector{},
+	}
+}
+
+func (r RustEnricher) GetSupportedLanguages() []string {
+	return []string{"rust"}
+}
+
+// DoEnrichLanguage runs DoFrameworkDetection with found rust project files.
+// rust project files: Cargo.toml
+func (r RustEnricher) DoEnrichLanguage(language *model.Language, files *[]string) {
+	cargoToml := utils.GetFile(files, "Cargo.toml")
+
+	if cargoToml != "" {
+		language.Tools = []string{"Cargo"}
+		detectRustFrameworks(language, cargoToml)
+	}
+}
+
+// DoEnrichComponent checks for the port number using a Dockerfile, Compose file, or Source strategy
+func (r RustEnricher) DoEnrichComponent(component *model.Component, settings model.DetectionSettings, ctx *context.Context) {
+	projectName := ""
+	cargoToml, err := utils.GetCargoTomlSchemaFromFile(filepath.Join(component.Path, "Cargo.toml"))
+	if err == nil {
+		projectName = cargoToml.Package.Name
+	}
+	if projectName == "" {
+		projectName = GetDefaultProjectName(component.Path)
+	}
+	component.Name = projectName
+
+	for _, algorithm := range settings.PortDetectionStrategy {
+		var ports []int
+		switch algorithm {
+		case model.DockerFile:
+			{
+				ports = GetPortsFromDockerFile(component.Path)
+				break
+			}
+		case model.Compose:
+			{
+				ports = GetPortsFromDockerComposeFile(component.Path, settings)
+				break
+			}
+		case model.Source:
+			{
+				for _, detector := range getRustFrameworkDetectors() {
+					for _, framework := range component.Languages[0].Frameworks {
+						if utils.Contains(detector.GetSupportedFrameworks(), framework) {
+							detector.DoPortsDetection(component, ctx)
+						}
+					}
+				}
+				// the bound addresses are searched once, after the framework specific lookups (e.g. Rocket.toml)
+				if len(component.Ports) == 0 {
+					framework.DoRustPortsDetection(component, ctx)
+				}
+			}
+		}
+		if len(ports) > 0 {
+			component.Ports = ports
+		}
+		if len(component.Ports) > 0 {
+			return
+		}
+	}
+}
+
+// IsConfigValidForComponentDetection checks if the Cargo.toml is valid for component detection.
+// The manifest of a virtual workspace only groups its members, which are detected as components
+// on their own, so it is not considered valid.
+func (r RustEnricher) IsConfigValidForComponentDetection(language string, config string) bool {
+	if !IsConfigurationValidForLanguage(language, config) {
+		return false
+	}
+	cargoToml, err := utils.GetCargoTomlSchemaFromFile(config)
+	if err != nil {
+		return true
+	}
+	return !framework.IsVirtualCargoWorkspace(cargoToml)
+}
+
+func detectRustFrameworks(language *model.Language, configFile string) {
+	for _, detector := range getRustFrameworkDetectors() {
+		detector.DoFrameworkDetection(language, configFile)
+	}
+}
@@ -0,0 +1,97 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package enricher
+
+import (
+	"context"
+
+	framework "github.com/devfile/alizer/pkg/apis/enricher/framework/scala"
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/utils"
+)
+
+type ScalaEnricher struct{}
+
+func getScalaFrameworkDetectors() []FrameworkDetectorWithConfigFile {
+	return []FrameworkDetectorWithConfigFile{
+		&framework.PlayDetector{},
+		&framework.AkkaHttpDetector{},
+		&framework.PekkoHttpDetector{},
+		&framework.Http4sDetector{},
+	}
+}
+
+func (s ScalaEnricher) GetSupportedLanguages() []string {
+	return []string{"scala"}
+}
+
+// DoEnrichLanguage runs DoFrameworkDetection with found scala project files.
+// scala project files: build.sbt
+func (s ScalaEnricher) DoEnrichLanguage(language *model.Language, files *[]string) {
+	buildSbt := utils.GetFile(files, "build.sbt")
+
+	if buildSbt != "" {
+		language.Tools = []string{"sbt"}
+		detectScalaFrameworks(language, buildSbt)
+	}
+}
+
+// DoEnrichComponent checks for the port number using a Dockerfile, Compose file, or Source strategy
+func (s ScalaEnricher) DoEnrichComponent(component *model.Component, settings model.DetectionSettings, ctx *context.Context) {
+	projectName := framework.GetSbtProjectName(component.Path)
+	if projectName == "" {
+		projectName = GetDefaultProjectName(component.Path)
+	}
+	component.Name = projectName
+
+	for _, algorithm := range settings.PortDetectionStrategy {
+		var ports []int
+		switch algorithm {
+		case model.DockerFile:
+			{
+				ports = GetPortsFromDockerFile(component.Path)
+				break
+			}
+		case model.Compose:
+			{
+				ports = GetPortsFromDockerComposeFile(component.Path, settings)
+				break
+			}
+		case model.Source:
+			{
+				for _, detector := range getScalaFrameworkDetectors() {
+					for _, framework := range component.Languages[0].Frameworks {
+						if utils.Contains(detector.GetSupportedFrameworks(), framework) {
+							detector.DoPortsDetection(component, ctx)
+						}
+					}
+				}
+			}
+		}
+		if len(ports) > 0 {
+			component.Ports = ports
+		}
+		if len(component.Ports) > 0 {
+			return
+		}
+	}
+}
+
+func (s ScalaEnricher) IsConfigValidForComponentDetection(language string, config string) bool {
+	return IsConfigurationValidForLanguage(language, config)
+}
+
+func detectScalaFrameworks(language *model.Language, configFile string) {
+	for _, detector := range getScalaFrameworkDetectors() {
+		detector.DoFrameworkDetection(language, configFile)
+	}
+}
@@ -80,6 +80,27 @@ type Component struct {
 
 	// Ports is the slice of integers (port values) detected
 	Ports []int
+
+	// Library is true if the component is a library of a monorepo, which is not deployed on its own
+	Library bool
+
+	// Dependencies is the slice of names of the other components of the monorepo this component depends on
+	Dependencies []string
+
+	// Parent is the name of the module aggregating this component in a multi-module build
+	Parent string
+}
+
+// ComponentStats represents the language statistics of a component detected inside the source tree
+type ComponentStats struct {
+	// Name is the name of the component
+	Name string
+
+	// Path is the root path of the component
+	Path string
+
+	// Languages is the slice of statistics for every language found inside the component
+	Languages []LanguageStats
 }
 
 // DetectionSettings represents the required settings for component detection
@@ -137,6 +158,16 @@ type EnvVar struct {
 	Value string
 }
 
+// KtorApplicationYaml represents the application.yaml file of ktor applications.
+// The port is either a number or a "$ENV_VAR:default" string.
+type KtorApplicationYaml struct {
+	Ktor struct {
+		Deployment struct {
+			Port interface{} `yaml:"port,omitempty"`
+		} `yaml:"deployment,omitempty"`
+	} `yaml:"ktor,omitempty"`
+}
+
 // Language represents every language detected from language analysis process
 type Language struct {
 	// Name is the name of the language
@@ -161,6 +192,30 @@ type Language struct {
 	CanBeContainerComponent bool
 }
 
+// LanguageStats represents the statistics of a language collected from the files of a source tree
+type LanguageStats struct {
+	// Name is the name of the language
+	Name string
+
+	// Files is the number of files written with this language
+	Files int
+
+	// Bytes is the total size in bytes of the files written with this language
+	Bytes int64
+
+	// Code is the number of lines of code
+	Code int
+
+	// Comments is the number of comment lines
+	Comments int
+
+	// Blanks is the number of blank lines
+	Blanks int
+
+	// Percentage is the share of lines of code of this language over all lines of code found
+	Percentage float64
+}
+
 // MicronautApplicationProps represents the application.properties file of micronaut applications
 type MicronautApplicationProps struct {
 	Micronaut struct {
@@ -243,6 +298,15 @@ type SpringApplicationProsServer struct {
 	} `yaml:"server,omitempty"`
 }
 
+// Stats represents the result of the language statistics process
+type Stats struct {
+	// Languages is the slice of statistics for every language found inside the source tree
+	Languages []LanguageStats
+
+	// Components is the slice of statistics for every component detected inside the source tree
+	Components []ComponentStats
+}
+
 // Version represents a version of a devfile
 type Version struct {
 	// SchemaVersion is the schemaVersion value of a devfile version
@@ -222,18 +222,20 @@ func DetectComponentsFromFilesList(files []string, settings model.DetectionSetti
 
 		alizerLogger.V(0).Info(fmt.Sprintf("File %s detected as configuration file for %d languages", file, len(languages)))
 		alizerLogger.V(1).Info("Searching for components based on this configuration file")
-		component, err := detectComponentUsingConfigFile(file, languages, settings, ctx)
+		detectedComponents, err := detectComponentUsingConfigFile(file, languages, settings, ctx)
 		if err != nil {
 			alizerLogger.V(1).Info(err.Error())
 			continue
 		}
-		if component.Languages[0].CanBeComponent {
-			alizerLogger.V(0).Info(fmt.Sprintf("Component %s found", component.Name))
-			components = appendIfMissing(components, component)
-		}
-		if component.Languages[0].CanBeContainerComponent {
-			alizerLogger.V(0).Info(fmt.Sprintf("Container component %s found", component.Name))
-			containerComponents = appendIfMissing(containerComponents, component)
+		for _, component := range detectedComponents {
+			if component.Languages[0].CanBeComponent {
+				alizerLogger.V(0).Info(fmt.Sprintf("Component %s found", component.Name))
+				components = appendIfMissing(components, component)
+			}
+			if component.Languages[0].CanBeContainerComponent {
+				alizerLogger.V(0).Info(fmt.Sprintf("Container component %s found", component.Name))
+				containerComponents = appendIfMissing(containerComponents, component)
+			}
 		}
 	}
 
@@ -289,17 +291,18 @@ func detectComponentByFolderAnalysis(root string, configLanguages []string, sett
 
 }
 
-// detectComponentByAnalyzingConfigFile returns a Component if found.
-func detectComponentByAnalyzingConfigFile(file string, language string, settings model.DetectionSettings, ctx *context.Context) (model.Component, error) {
+// detectComponentByAnalyzingConfigFile returns the Components defined by the config file if found.
+// A config file defines many components if the enricher of its language splits them (e.g. the main packages of a Go module).
+func detectComponentByAnalyzingConfigFile(file string, language string, settings model.DetectionSettings, ctx *context.Context) ([]model.Component, error) {
 	alizerLogger := utils.GetOrCreateLogger()
 	alizerLogger.V(1).Info("Analyzing config file for singe language or family of languages")
-	if !isConfigurationValid(language, file) {
-		return model.Component{}, errors.New("language not valid for component detection")
+	if !isConfigurationValid(language, file, ctx) {
+		return []model.Component{}, errors.New("language not valid for component detection")
 	}
 	dir, _ := utils.NormalizeSplit(file)
-	lang, err := AnalyzeFile(file, language)
+	lang, err := analyzeFile(file, language, ctx)
 	if err != nil {
-		return model.Component{}, err
+		return []model.Component{}, err
 	}
 	component := model.Component{
 		Path: dir,
@@ -307,29 +310,67 @@ func detectComponentByAnalyzingConfigFile(file string, language string, settings
 			lang,
 		},
 	}
-	enrichComponent(&component, settings, ctx)
-	return component, nil
+	components := splitComponent(component, ctx)
+	for index := range components {
+		enrichComponent(&components[index], settings, ctx)
+	}
+	return components, nil
 }
 
 func doBelongToSameFamily(languages []string) bool {
 	return len(languages) == 2 &&
 		languages[0] != languages[1] &&
-		(strings.ToLower(languages[0]) == "typescript" || strings.ToLower(languages[0]) == "javascript") &&
-		(strings.ToLower(languages[1]) == "typescript" || strings.ToLower(languages[1]) == "javascript")
+		(isLanguageInFamily(languages, "typescript", "javascript") || isLanguageInFamily(languages, "java", "kotlin"))
+}
+
+// isLanguageInFamily checks if both languages are one of the two members of the family
+func isLanguageInFamily(languages []string, firstMember string, secondMember string) bool {
+	for _, language := range languages {
+		if !strings.EqualFold(language, firstMember) && !strings.EqualFold(language, secondMember) {
+			return false
+		}
+	}
+	return true
 }
 
-func detectComponentUsingConfigFile(file string, languages []string, settings model.DetectionSettings, ctx *context.Context) (model.Component, error) {
+func detectComponentUsingConfigFile(file string, languages []string, settings model.DetectionSettings, ctx *context.Context) ([]model.Component, error) {
 	if len(languages) == 1 || doBelongToSameFamily(languages) {
 		return detectComponentByAnalyzingConfigFile(file, languages[0], settings, ctx)
 	} else {
 		dir, _ := utils.NormalizeSplit(file)
 		for _, language := range languages {
-			if isConfigurationValid(language, file) {
-				return detectComponentByFolderAnalysis(dir, languages, settings, ctx)
+			if isConfigurationValid(language, file, ctx) {
+				component, err := detectComponentByFolderAnalysis(dir, languages, settings, ctx)
+				if err != nil {
+					return []model.Component{}, err
+				}
+				// the main language of the folder may not consider the file valid, e.g. the package.json at the
+				// root of a JavaScript monorepo, even if another language sharing the file does
+				if mainLanguage := component.Languages[0].Name; isLanguageInList(mainLanguage, languages) && !isConfigurationValid(mainLanguage, file, ctx) {
+					return []model.Component{}, errors.New("no component detected")
+				}
+				return []model.Component{component}, nil
 			}
 		}
 	}
-	return model.Component{}, errors.New("no component detected")
+	return []model.Component{}, errors.New("no component detected")
+}
+
+func isLanguageInList(language string, languages []string) bool {
+	for _, item := range languages {
+		if strings.EqualFold(item, language) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitComponent returns the components the enricher of the main language splits component into, if any
+func splitComponent(component model.Component, ctx *context.Context) []model.Component {
+	if componentSplitter, ok := enricher.GetEnricherByLanguage(component.Languages[0].Name).(enricher.ComponentSplitter); ok {
+		return componentSplitter.SplitComponent(component, ctx)
+	}
+	return []model.Component{component}
 }
 
 func enrichComponent(component *model.Component, settings model.DetectionSettings, ctx *context.Context) {
@@ -357,10 +398,13 @@ func getLanguagesWeightedByConfigFile(languages []model.Language, configLanguage
 	return languages
 }
 
-func isConfigurationValid(language string, file string) bool {
+func isConfigurationValid(language string, file string, ctx *context.Context) bool {
 	langEnricher := enricher.GetEnricherByLanguage(language)
-	if langEnricher != nil {
-		return langEnricher.IsConfigValidForComponentDetection(language, file)
+	if langEnricher == nil {
+		return false
 	}
-	return false
+	if validator, ok := langEnricher.(enricher.ComponentConfigValidator); ok {
+		return validator.IsConfigValidForComponentDetectionWithContext(language, file, ctx)
+	}
+	return langEnricher.IsConfigValidForComponentDetection(language, file)
 }
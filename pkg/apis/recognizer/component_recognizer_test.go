@@ -22,9 +22,13 @@ func TestDetectComponentsWithoutPortDetection(t *testing.T) {
 		{
 			name: "Case 1: Func successful",
 			path: "../../../resources/projects/beego",
+			// each main package of the module is a component
 			components: []model.Component{{
-				Name: "beego",
-				Path: "../../../resources/projects/beego",
+				Name: "loadConfig",
+				Path: "../../../resources/projects/beego/config/loadConfig",
+			}, {
+				Name: "webAutoLoadConfig",
+				Path: "../../../resources/projects/beego/config/webAutoLoadConfig",
 			}},
 		},
 	}
@@ -38,8 +42,12 @@ func TestDetectComponentsWithoutPortDetection(t *testing.T) {
 			if err != nil {
 				t.Errorf("Error: %t", err)
 			}
+			expectedPath, err := getAbsolutePath(tt.components[0].Path)
+			if err != nil {
+				t.Errorf("Error: %t", err)
+			}
 			assert.EqualValues(t, tt.components[0].Name, result[0].Name)
-			assert.EqualValues(t, absPath, result[0].Path)
+			assert.EqualValues(t, expectedPath, result[0].Path)
 		})
 	}
 }
@@ -108,8 +116,12 @@ func Test_detectComponentsWithSettings(t *testing.T) {
 			path: "../../../resources/projects/beego",
 			expectedComponents: []model.Component{
 				{
-					Name: "beego",
-					Path: "../../../resources/projects/beego",
+					Name: "loadConfig",
+					Path: "../../../resources/projects/beego/config/loadConfig",
+				},
+				{
+					Name: "webAutoLoadConfig",
+					Path: "../../../resources/projects/beego/config/webAutoLoadConfig",
 				},
 			},
 			expectingError: false,
@@ -136,8 +148,8 @@ func Test_detectComponentsWithSettings(t *testing.T) {
 				}
 				assert.EqualValues(t, 0, len(result))
 			} else {
-				if len(result) != 1 {
-					t.Errorf("expected 1 component for %s dir", tt.path)
+				if len(result) != len(tt.expectedComponents) {
+					t.Errorf("expected %d components for %s dir", len(tt.expectedComponents), tt.path)
 				}
 				expectedPath, err := getAbsolutePath(tt.expectedComponents[0].Path)
 				if err != nil {
@@ -162,8 +174,12 @@ func TestDetectComponentsWithSettings(t *testing.T) {
 			path: "../../../resources/projects/beego",
 			expectedComponents: []model.Component{
 				{
-					Name: "beego",
-					Path: "../../../resources/projects/beego",
+					Name: "loadConfig",
+					Path: "../../../resources/projects/beego/config/loadConfig",
+				},
+				{
+					Name: "webAutoLoadConfig",
+					Path: "../../../resources/projects/beego/config/webAutoLoadConfig",
 				},
 			},
 			expectingError: false,
@@ -189,8 +205,8 @@ func TestDetectComponentsWithSettings(t *testing.T) {
 				}
 				assert.EqualValues(t, 0, len(result))
 			} else {
-				if len(result) != 1 {
-					t.Errorf("expected 1 component for %s dir", tt.path)
+				if len(result) != len(tt.expectedComponents) {
+					t.Errorf("expected %d components for %s dir", len(tt.expectedComponents), tt.path)
 				}
 				expectedPath, err := getAbsolutePath(tt.expectedComponents[0].Path)
 				if err != nil {
@@ -215,8 +231,12 @@ func Test_detectComponentsInRootWithSettings(t *testing.T) {
 			path: "../../../resources/projects/beego",
 			expectedComponents: []model.Component{
 				{
-					Name: "beego",
-					Path: "../../../resources/projects/beego",
+					Name: "loadConfig",
+					Path: "../../../resources/projects/beego/config/loadConfig",
+				},
+				{
+					Name: "webAutoLoadConfig",
+					Path: "../../../resources/projects/beego/config/webAutoLoadConfig",
 				},
 			},
 			expectingError: false,
@@ -243,8 +263,8 @@ func Test_detectComponentsInRootWithSettings(t *testing.T) {
 				}
 				assert.EqualValues(t, 0, len(result))
 			} else {
-				if len(result) != 1 {
-					t.Errorf("expected 1 component for %s dir", tt.path)
+				if len(result) != len(tt.expectedComponents) {
+					t.Errorf("expected %d components for %s dir", len(tt.expectedComponents), tt.path)
 				}
 				expectedPath, err := getAbsolutePath(tt.expectedComponents[0].Path)
 				if err != nil {
@@ -269,8 +289,12 @@ func TestDetectComponentsInRoot(t *testing.T) {
 			path: "../../../resources/projects/beego",
 			expectedComponents: []model.Component{
 				{
-					Name: "beego",
-					Path: "../../../resources/projects/beego",
+					Name: "loadConfig",
+					Path: "../../../resources/projects/beego/config/loadConfig",
+				},
+				{
+					Name: "webAutoLoadConfig",
+					Path: "../../../resources/projects/beego/config/webAutoLoadConfig",
 				},
 			},
 			expectingError: false,
@@ -296,8 +320,8 @@ func TestDetectComponentsInRoot(t *testing.T) {
 				}
 				assert.EqualValues(t, 0, len(result))
 			} else {
-				if len(result) != 1 {
-					t.Errorf("expected 1 component for %s dir", tt.path)
+				if len(result) != len(tt.expectedComponents) {
+					t.Errorf("expected %d components for %s dir", len(tt.expectedComponents), tt.path)
 				}
 				expectedPath, err := getAbsolutePath(tt.expectedComponents[0].Path)
 				if err != nil {
@@ -322,8 +346,12 @@ func TestDetectComponents(t *testing.T) {
 			path: "../../../resources/projects/beego",
 			expectedComponents: []model.Component{
 				{
-					Name: "beego",
-					Path: "../../../resources/projects/beego",
+					Name: "loadConfig",
+					Path: "../../../resources/projects/beego/config/loadConfig",
+				},
+				{
+					Name: "webAutoLoadConfig",
+					Path: "../../../resources/projects/beego/config/webAutoLoadConfig",
 				},
 			},
 			expectingError: false,
@@ -349,8 +377,8 @@ func TestDetectComponents(t *testing.T) {
 				}
 				assert.EqualValues(t, 0, len(result))
 			} else {
-				if len(result) != 1 {
-					t.Errorf("expected 1 component for %s dir", tt.path)
+				if len(result) != len(tt.expectedComponents) {
+					t.Errorf("expected %d components for %s dir", len(tt.expectedComponents), tt.path)
 				}
 				expectedPath, err := getAbsolutePath(tt.expectedComponents[0].Path)
 				if err != nil {
@@ -375,8 +403,12 @@ func TestDetectComponentsInRootWithSettings(t *testing.T) {
 			path: "../../../resources/projects/beego",
 			expectedComponents: []model.Component{
 				{
-					Name: "beego",
-					Path: "../../../resources/projects/beego",
+					Name: "loadConfig",
+					Path: "../../../resources/projects/beego/config/loadConfig",
+				},
+				{
+					Name: "webAutoLoadConfig",
+					Path: "../../../resources/projects/beego/config/webAutoLoadConfig",
 				},
 			},
 			expectingError: false,
@@ -402,8 +434,8 @@ func TestDetectComponentsInRootWithSettings(t *testing.T) {
 				}
 				assert.EqualValues(t, 0, len(result))
 			} else {
-				if len(result) != 1 {
-					t.Errorf("expected 1 component for %s dir", tt.path)
+				if len(result) != len(tt.expectedComponents) {
+					t.Errorf("expected %d components for %s dir", len(tt.expectedComponents), tt.path)
 				}
 				expectedPath, err := getAbsolutePath(tt.expectedComponents[0].Path)
 				if err != nil {
@@ -428,8 +460,12 @@ func Test_detectComponentsWithPathAndPortStartegy(t *testing.T) {
 			path: "../../../resources/projects/beego",
 			expectedComponents: []model.Component{
 				{
-					Name: "beego",
-					Path: "../../../resources/projects/beego",
+					Name: "loadConfig",
+					Path: "../../../resources/projects/beego/config/loadConfig",
+				},
+				{
+					Name: "webAutoLoadConfig",
+					Path: "../../../resources/projects/beego/config/webAutoLoadConfig",
 				},
 			},
 			expectingError: false,
@@ -456,8 +492,8 @@ func Test_detectComponentsWithPathAndPortStartegy(t *testing.T) {
 				}
 				assert.EqualValues(t, 0, len(result))
 			} else {
-				if len(result) != 1 {
-					t.Errorf("expected 1 component for %s dir", tt.path)
+				if len(result) != len(tt.expectedComponents) {
+					t.Errorf("expected %d components for %s dir", len(tt.expectedComponents), tt.path)
 				}
 				expectedPath, err := getAbsolutePath(tt.expectedComponents[0].Path)
 				if err != nil {
@@ -482,8 +518,12 @@ func TestDetectComponentsWithPathAndPortStartegy(t *testing.T) {
 			path: "../../../resources/projects/beego",
 			expectedComponents: []model.Component{
 				{
-					Name: "beego",
-					Path: "../../../resources/projects/beego",
+					Name: "loadConfig",
+					Path: "../../../resources/projects/beego/config/loadConfig",
+				},
+				{
+					Name: "webAutoLoadConfig",
+					Path: "../../../resources/projects/beego/config/webAutoLoadConfig",
 				},
 			},
 			expectingError: false,
@@ -509,8 +549,8 @@ func TestDetectComponentsWithPathAndPortStartegy(t *testing.T) {
 				}
 				assert.EqualValues(t, 0, len(result))
 			} else {
-				if len(result) != 1 {
-					t.Errorf("expected 1 component for %s dir", tt.path)
+				if len(result) != len(tt.expectedComponents) {
+					t.Errorf("expected %d components for %s dir", len(tt.expectedComponents), tt.path)
 				}
 				expectedPath, err := getAbsolutePath(tt.expectedComponents[0].Path)
 				if err != nil {
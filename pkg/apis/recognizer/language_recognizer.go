@@ -105,10 +105,7 @@ func analyze(path string, ctx *context.Context) ([]model.Language, error) {
 				Frameworks:     []string{},
 				Tools:          []string{},
 				CanBeComponent: item.item.Component}
-			langEnricher := enricher.GetEnricherByLanguage(name)
-			if langEnricher != nil {
-				langEnricher.DoEnrichLanguage(&tmpLanguage, &paths)
-			}
+			enrichLanguage(&tmpLanguage, &paths, ctx)
 			alizerLogger.V(0).Info(fmt.Sprintf("%s weight is %f. Detecting frameworks", tmpLanguage.Name, tmpLanguage.Weight))
 			languagesFound = append(languagesFound, tmpLanguage)
 		}
@@ -122,6 +119,11 @@ func analyze(path string, ctx *context.Context) ([]model.Language, error) {
 }
 
 func AnalyzeFile(configFile string, targetLanguage string) (model.Language, error) {
+	ctx := context.Background()
+	return analyzeFile(configFile, targetLanguage, &ctx)
+}
+
+func analyzeFile(configFile string, targetLanguage string, ctx *context.Context) (model.Language, error) {
 	lang, err := langfile.Get().GetLanguageByName(targetLanguage)
 	if err != nil {
 		return model.Language{}, err
@@ -135,13 +137,23 @@ func AnalyzeFile(configFile string, targetLanguage string) (model.Language, erro
 		CanBeComponent:          lang.Component,
 		CanBeContainerComponent: lang.ContainerComponent,
 	}
-	langEnricher := enricher.GetEnricherByLanguage(targetLanguage)
-	if langEnricher != nil {
-		langEnricher.DoEnrichLanguage(&tmpLanguage, &[]string{configFile})
-	}
+	enrichLanguage(&tmpLanguage, &[]string{configFile}, ctx)
 	return tmpLanguage, nil
 }
 
+// enrichLanguage detects the frameworks and tools of the language with its enricher, if any
+func enrichLanguage(language *model.Language, files *[]string, ctx *context.Context) {
+	langEnricher := enricher.GetEnricherByLanguage(language.Name)
+	if langEnricher == nil {
+		return
+	}
+	if contextEnricher, ok := langEnricher.(enricher.LanguageEnricherWithContext); ok {
+		contextEnricher.DoEnrichLanguageWithContext(language, files, ctx)
+		return
+	}
+	langEnricher.DoEnrichLanguage(language, files)
+}
+
 func isStaticFileExtension(path string) bool {
 	staticDirs := [4]string{"static/", "templates/", "META-INF/resources/", "public/"}
 	for _, dir := range staticDirs {
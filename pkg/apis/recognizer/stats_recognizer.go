@@ -0,0 +1,222 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package recognizer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/schema"
+	"github.com/devfile/alizer/pkg/utils"
+	langfile "github.com/devfile/alizer/pkg/utils/langfiles"
+)
+
+// Stats returns the number of files, bytes and lines of code, comments and blanks
+// for every language found in path, both for the whole source tree and for every component detected.
+func Stats(path string) (model.Stats, error) {
+	ctx := context.Background()
+	return stats(path, &ctx)
+}
+
+func stats(path string, ctx *context.Context) (model.Stats, error) {
+	alizerLogger := utils.GetOrCreateLogger()
+	alizerLogger.V(0).Info("Collecting language statistics")
+	paths, err := utils.GetCachedFilePathsFromRoot(path, ctx)
+	if err != nil {
+		return model.Stats{}, err
+	}
+	result := model.Stats{
+		Languages:  getLanguagesStats(paths),
+		Components: []model.ComponentStats{},
+	}
+
+	alizerLogger.V(0).Info("Collecting language statistics for detected components")
+	components, err := detectComponentsWithPathAndPortStartegy(path, []model.PortDetectionAlgorithm{}, ctx)
+	if err != nil {
+		return model.Stats{}, err
+	}
+	for _, component := range components {
+		componentPaths, err := utils.GetCachedFilePathsFromRoot(component.Path, ctx)
+		if err != nil {
+			alizerLogger.V(1).Info(fmt.Sprintf("Not able to get file paths for component %s", component.Name))
+			continue
+		}
+		result.Components = append(result.Components, model.ComponentStats{
+			Name:      component.Name,
+			Path:      component.Path,
+			Languages: getLanguagesStats(componentPaths),
+		})
+	}
+	return result, nil
+}
+
+// getLanguagesStats groups the given paths by language and counts their files, bytes and lines.
+// Prose files (e.g. Text, Markdown) are not source code and are skipped.
+// Languages are sorted by lines of code, from the biggest to the smallest.
+func getLanguagesStats(paths []string) []model.LanguageStats {
+	languagesFile := langfile.Get()
+	statsPerLanguage := make(map[string]*model.LanguageStats)
+	totalCode := 0
+	for _, path := range paths {
+		language, found := getLanguageOfFile(path)
+		if !found || language.Kind == "prose" {
+			continue
+		}
+		fileInfo, err := os.Stat(path)
+		if err != nil || fileInfo.IsDir() {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			continue
+		}
+		languageStats, exists := statsPerLanguage[language.Name]
+		if !exists {
+			languageStats = &model.LanguageStats{Name: language.Name}
+			statsPerLanguage[language.Name] = languageStats
+		}
+		code, comments, blanks := countLines(content, languagesFile.GetLanguageComments(language.Name))
+		languageStats.Files++
+		languageStats.Bytes += fileInfo.Size()
+		languageStats.Code += code
+		languageStats.Comments += comments
+		languageStats.Blanks += blanks
+		totalCode += code
+	}
+
+	languagesStats := []model.LanguageStats{}
+	for _, languageStats := range statsPerLanguage {
+		if totalCode > 0 {
+			percentage := float64(languageStats.Code) / float64(totalCode) * 100
+			languageStats.Percentage = math.Round(percentage*100) / 100
+		}
+		languagesStats = append(languagesStats, *languageStats)
+	}
+	sort.SliceStable(languagesStats, func(i, j int) bool {
+		if languagesStats[i].Code != languagesStats[j].Code {
+			return languagesStats[i].Code > languagesStats[j].Code
+		}
+		return languagesStats[i].Name < languagesStats[j].Name
+	})
+	return languagesStats
+}
+
+// getLanguageOfFile returns the language of a file by looking at its name first and then at its extension.
+// When more languages share the same extension, programming languages are preferred and ties are
+// broken by name so that the result does not change between runs.
+func getLanguageOfFile(path string) (langfile.LanguageItem, bool) {
+	languagesFile := langfile.Get()
+	filename := filepath.Base(path)
+	candidates := languagesFile.GetLanguagesByFilename(filename)
+	if len(candidates) == 0 {
+		candidates = languagesFile.GetLanguagesByExtension(filepath.Ext(filename))
+	}
+	if len(candidates) == 0 {
+		return langfile.LanguageItem{}, false
+	}
+
+	selected := candidates[0]
+	for _, candidate := range candidates[1:] {
+		selectedIsProgramming := selected.Kind == "programming"
+		candidateIsProgramming := candidate.Kind == "programming"
+		if candidateIsProgramming != selectedIsProgramming {
+			if candidateIsProgramming {
+				selected = candidate
+			}
+			continue
+		}
+		if candidate.Name < selected.Name {
+			selected = candidate
+		}
+	}
+	return selected, true
+}
+
+// countLines returns the number of lines of code, comments and blanks of content.
+// A line containing both code and comments is counted as code.
+func countLines(content []byte, comments schema.LanguageComments) (int, int, int) {
+	code, commentLines, blanks := 0, 0, 0
+	inBlockComment := false
+	blockCommentEnd := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			blanks++
+			continue
+		}
+
+		isCode := false
+		for line != "" {
+			if inBlockComment {
+				endIndex := strings.Index(line, blockCommentEnd)
+				if endIndex == -1 {
+					break
+				}
+				line = strings.TrimSpace(line[endIndex+len(blockCommentEnd):])
+				inBlockComment = false
+				continue
+			}
+			index, blockComment, isBlockComment := getFirstComment(line, comments)
+			if index != 0 {
+				isCode = true
+			}
+			if !isBlockComment {
+				break
+			}
+			line = strings.TrimSpace(line[index+len(blockComment.Start):])
+			inBlockComment = true
+			blockCommentEnd = blockComment.End
+		}
+
+		if isCode {
+			code++
+		} else {
+			commentLines++
+		}
+	}
+	return code, commentLines, blanks
+}
+
+// getFirstComment returns the index of the first comment of line, or -1 if there is none, and whether it starts
+// a block comment. When a line comment and a block comment start at the same index (e.g. -- and --[[ in Lua),
+// the longest one wins.
+func getFirstComment(line string, comments schema.LanguageComments) (int, schema.BlockComment, bool) {
+	firstIndex, firstLength := -1, 0
+	firstBlockComment, isBlockComment := schema.BlockComment{}, false
+	isBefore := func(index int, length int) bool {
+		return length > 0 && index != -1 && (firstIndex == -1 || index < firstIndex || (index == firstIndex && length > firstLength))
+	}
+	for _, blockComment := range comments.BlockComments {
+		if index := strings.Index(line, blockComment.Start); isBefore(index, len(blockComment.Start)) {
+			firstIndex, firstLength = index, len(blockComment.Start)
+			firstBlockComment, isBlockComment = blockComment, true
+		}
+	}
+	for _, lineComment := range comments.LineComments {
+		if index := strings.Index(line, lineComment); isBefore(index, len(lineComment)) {
+			firstIndex, firstLength = index, len(lineComment)
+			firstBlockComment, isBlockComment = schema.BlockComment{}, false
+		}
+	}
+	return firstIndex, firstBlockComment, isBlockComment
+}
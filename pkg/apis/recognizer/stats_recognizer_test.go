@@ -0,0 +1,205 @@
+package recognizer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/devfile/alizer/pkg/apis/model"
+	"github.com/devfile/alizer/pkg/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"go.mod":    "module github.com/example/stats\n\ngo 1.21\n",
+		"main.go":   "package main\n\n// main starts the app\nfunc main() {\n\t/* nothing\n\tto do */\n}\n",
+		"README.md": "# Stats\n",
+		"build.sh":  "#!/bin/sh\n# build\ngo build ./...\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := Stats(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedLanguages := []model.LanguageStats{
+		{Name: "Go", Files: 1, Bytes: int64(len(files["main.go"])), Code: 3, Comments: 3, Blanks: 1, Percentage: 75},
+		{Name: "Shell", Files: 1, Bytes: int64(len(files["build.sh"])), Code: 1, Comments: 2, Blanks: 0, Percentage: 25},
+	}
+	assert.EqualValues(t, expectedLanguages, result.Languages)
+	if assert.Len(t, result.Components, 1) {
+		assert.EqualValues(t, "stats", result.Components[0].Name)
+		assert.EqualValues(t, expectedLanguages, result.Components[0].Languages)
+	}
+}
+
+func TestStatsPercentageRounding(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"main.go": "package main\n\nfunc main() {}\n",
+		"run.sh":  "echo run\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := Stats(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, result.Languages, 2) {
+		assert.EqualValues(t, 66.67, result.Languages[0].Percentage)
+		assert.EqualValues(t, 33.33, result.Languages[1].Percentage)
+	}
+}
+
+func TestStatsInvalidPath(t *testing.T) {
+	_, err := Stats("../../../resources/projects/notexisting")
+	assert.Error(t, err)
+}
+
+func Test_countLines(t *testing.T) {
+	cStyle := schema.LanguageComments{
+		LineComments:  []string{"//"},
+		BlockComments: []schema.BlockComment{{Start: "/*", End: "*/"}},
+	}
+	tests := []struct {
+		name             string
+		content          string
+		comments         schema.LanguageComments
+		expectedCode     int
+		expectedComments int
+		expectedBlanks   int
+	}{
+		{
+			name:             "Case 1: code, comments and blanks",
+			content:          "package main\n\n// comment\nfunc main() {}\n",
+			comments:         cStyle,
+			expectedCode:     2,
+			expectedComments: 1,
+			expectedBlanks:   1,
+		},
+		{
+			name:             "Case 2: multiline block comment",
+			content:          "/*\n * license\n */\npackage main\n",
+			comments:         cStyle,
+			expectedCode:     1,
+			expectedComments: 3,
+		},
+		{
+			name:             "Case 3: code after a block comment on the same line",
+			content:          "/* inline */ var a = 1\n",
+			comments:         cStyle,
+			expectedCode:     1,
+			expectedComments: 0,
+		},
+		{
+			name:             "Case 4: code followed by a comment",
+			content:          "var a = 1 // one\n",
+			comments:         cStyle,
+			expectedCode:     1,
+			expectedComments: 0,
+		},
+		{
+			name:    "Case 5: block comment with same start and end",
+			content: "\"\"\"\ndocstring\n\"\"\"\nimport os\n# comment\n",
+			comments: schema.LanguageComments{
+				LineComments:  []string{"#"},
+				BlockComments: []schema.BlockComment{{Start: "\"\"\"", End: "\"\"\""}},
+			},
+			expectedCode:     1,
+			expectedComments: 4,
+		},
+		{
+			name:           "Case 6: unknown comment syntax",
+			content:        "# not a comment\n\nvalue\n",
+			comments:       schema.LanguageComments{},
+			expectedCode:   2,
+			expectedBlanks: 1,
+		},
+		{
+			name:             "Case 7: block comment starting after code",
+			content:          "x = 1; /*\n * first\n * second\n */\ny = 2;\n",
+			comments:         cStyle,
+			expectedCode:     2,
+			expectedComments: 3,
+		},
+		{
+			name:             "Case 8: block comment closed on the line it starts after code",
+			content:          "x = 1; /* one */ y = 2; /* two\n */\n",
+			comments:         cStyle,
+			expectedCode:     1,
+			expectedComments: 1,
+		},
+		{
+			name:    "Case 9: block comment starting with a line comment",
+			content: "--[[\nlicense\n]]\nlocal a = 1 -- one\n",
+			comments: schema.LanguageComments{
+				LineComments:  []string{"--"},
+				BlockComments: []schema.BlockComment{{Start: "--[[", End: "]]"}},
+			},
+			expectedCode:     1,
+			expectedComments: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, comments, blanks := countLines([]byte(tt.content), tt.comments)
+			assert.EqualValues(t, tt.expectedCode, code)
+			assert.EqualValues(t, tt.expectedComments, comments)
+			assert.EqualValues(t, tt.expectedBlanks, blanks)
+		})
+	}
+}
+
+func Test_getLanguageOfFile(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		expectedName  string
+		expectedFound bool
+	}{
+		{
+			name:          "Case 1: language from extension",
+			path:          "/project/main.go",
+			expectedName:  "Go",
+			expectedFound: true,
+		},
+		{
+			name:          "Case 2: language from filename",
+			path:          "/project/Dockerfile",
+			expectedName:  "Dockerfile",
+			expectedFound: true,
+		},
+		{
+			name:          "Case 3: programming language preferred on shared extension",
+			path:          "/project/index.ts",
+			expectedName:  "TypeScript",
+			expectedFound: true,
+		},
+		{
+			name:          "Case 4: unknown extension",
+			path:          "/project/file.notaextension",
+			expectedFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			language, found := getLanguageOfFile(tt.path)
+			assert.EqualValues(t, tt.expectedFound, found)
+			assert.EqualValues(t, tt.expectedName, language.Name)
+		})
+	}
+}
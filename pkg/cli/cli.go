@@ -8,6 +8,7 @@ import (
 	"github.com/devfile/alizer/pkg/cli/analyze"
 	"github.com/devfile/alizer/pkg/cli/component"
 	"github.com/devfile/alizer/pkg/cli/devfile"
+	"github.com/devfile/alizer/pkg/cli/stats"
 	"github.com/devfile/alizer/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -28,6 +29,9 @@ Find out more at https://github.com/devfile/alizer
 
   # Select one devfile based on the informations found in the source tree:
     alizer devfile /your/local/project/path
+
+  # Count files and lines of code, comments and blanks per language and component:
+    alizer stats /your/local/project/path
 	`
 
 	rootHelpMessage = "To see a full list of commands, run 'alizer --help'"
@@ -64,6 +68,7 @@ func AlizerCommands() *cobra.Command {
 		analyze.NewCmdAnalyze(),
 		component.NewCmdComponent(),
 		devfile.NewCmdDevfile(),
+		stats.NewCmdStats(),
 	)
 
 	rootCmd.AddCommand(rootCmdList...)
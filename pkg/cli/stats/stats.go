@@ -0,0 +1,37 @@
+package stats
+
+import (
+	"github.com/devfile/alizer/pkg/apis/recognizer"
+	"github.com/devfile/alizer/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+var logLevel string
+
+func NewCmdStats() *cobra.Command {
+	statsCmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Count files, bytes and lines of code, comments and blanks for every language in the source tree",
+		Long: `Count files, bytes and lines of code, comments and blanks for every language in the source tree.
+Statistics are reported both for the whole source tree and for every component detected inside it`,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     doStats,
+		Example: `  alizer stats /your/local/project/path`,
+	}
+	statsCmd.Flags().StringVar(&logLevel, "log", "", "log level for alizer. Default value: error. Accepted values: [debug, info, warning]")
+
+	return statsCmd
+}
+
+func doStats(cmd *cobra.Command, args []string) {
+	if len(args) == 0 {
+		utils.PrintNoArgsWarningMessage(cmd.Name())
+		return
+	}
+	err := utils.GenLogger(logLevel)
+	if err != nil {
+		utils.PrintWrongLoggingLevelMessage(cmd.Name())
+		return
+	}
+	utils.PrintPrettifyOutput(recognizer.Stats(args[0]))
+}
@@ -0,0 +1,29 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+package schema
+
+type DotNetLaunchSettings struct {
+	Profiles map[string]DotNetLaunchProfile `json:"profiles"`
+}
+
+type DotNetLaunchProfile struct {
+	CommandName          string            `json:"commandName"`
+	ApplicationUrl       string            `json:"applicationUrl"`
+	EnvironmentVariables map[string]string `json:"environmentVariables"`
+}
+
+type DotNetAppSettings struct {
+	Kestrel struct {
+		Endpoints map[string]struct {
+			Url string `json:"Url"`
+		} `json:"Endpoints"`
+	} `json:"Kestrel"`
+}
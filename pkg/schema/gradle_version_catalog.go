@@ -0,0 +1,20 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+package schema
+
+// GradleVersionCatalog is a Gradle version catalog, e.g. gradle/libs.versions.toml. Libraries and plugins can be
+// declared with a string notation or with a table, so their values are kept untyped.
+type GradleVersionCatalog struct {
+	Versions  map[string]interface{} `toml:"versions"`
+	Libraries map[string]interface{} `toml:"libraries"`
+	Bundles   map[string][]string    `toml:"bundles"`
+	Plugins   map[string]interface{} `toml:"plugins"`
+}
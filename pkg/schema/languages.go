@@ -36,3 +36,15 @@ type LanguageCustomization struct {
 }
 
 type LanguagesCustomizations map[string]LanguageCustomization
+
+type BlockComment struct {
+	Start string `yaml:"start"`
+	End   string `yaml:"end"`
+}
+
+type LanguageComments struct {
+	LineComments  []string       `yaml:"line_comments,omitempty"`
+	BlockComments []BlockComment `yaml:"block_comments,omitempty"`
+}
+
+type LanguagesComments map[string]LanguageComments
@@ -0,0 +1,35 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+package schema
+
+type NxProjectJson struct {
+	Name                 string              `json:"name"`
+	ProjectType          string              `json:"projectType"`
+	SourceRoot           string              `json:"sourceRoot"`
+	Targets              map[string]NxTarget `json:"targets"`
+	ImplicitDependencies []string            `json:"implicitDependencies"`
+}
+
+type NxTarget struct {
+	Executor string                 `json:"executor"`
+	Options  map[string]interface{} `json:"options"`
+}
+
+type LernaJson struct {
+	Packages      []string `json:"packages"`
+	UseWorkspaces bool     `json:"useWorkspaces"`
+}
+
+type TsConfigJson struct {
+	CompilerOptions struct {
+		Paths map[string][]string `json:"paths"`
+	} `json:"compilerOptions"`
+}
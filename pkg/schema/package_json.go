@@ -10,15 +10,40 @@
  ******************************************************************************/
 package schema
 
+import "encoding/json"
+
 type PackageJson struct {
 	Name             string            `json:"name"`
 	Dependencies     map[string]string `json:"dependencies"`
 	DevDependencies  map[string]string `json:"devDependencies"`
 	PeerDependencies map[string]string `json:"peerDependencies"`
 	Scripts          Script            `json:"scripts"`
+	PackageManager   string            `json:"packageManager"`
+	Workspaces       Workspaces        `json:"workspaces"`
 }
 
 type Script struct {
 	Dev   string `json:"dev"`
+	Serve string `json:"serve"`
 	Start string `json:"start"`
 }
+
+// Workspaces is the list of globs matching the packages of a workspace. It can be declared
+// as an array or, with Yarn classic, as an object with a packages field.
+type Workspaces []string
+
+func (w *Workspaces) UnmarshalJSON(data []byte) error {
+	var packages []string
+	if err := json.Unmarshal(data, &packages); err == nil {
+		*w = packages
+		return nil
+	}
+	var workspacesObject struct {
+		Packages []string `json:"packages"`
+	}
+	if err := json.Unmarshal(data, &workspacesObject); err != nil {
+		return err
+	}
+	*w = workspacesObject.Packages
+	return nil
+}
@@ -10,7 +10,35 @@
  ******************************************************************************/
 package schema
 
+import "encoding/xml"
+
 type Pom struct {
+	GroupId   string `xml:"groupId"`
+	Version   string `xml:"version"`
+	Packaging string `xml:"packaging"`
+	Parent    struct {
+		GroupId      string  `xml:"groupId"`
+		ArtifactId   string  `xml:"artifactId"`
+		Version      string  `xml:"version"`
+		RelativePath *string `xml:"relativePath"`
+	} `xml:"parent"`
+	Properties struct {
+		Property []struct {
+			XMLName xml.Name
+			Value   string `xml:",chardata"`
+		} `xml:",any"`
+	} `xml:"properties"`
+	DependencyManagement struct {
+		Dependencies struct {
+			Dependency []struct {
+				GroupId    string `xml:"groupId"`
+				ArtifactId string `xml:"artifactId"`
+				Version    string `xml:"version"`
+				Type       string `xml:"type"`
+				Scope      string `xml:"scope"`
+			} `xml:"dependency"`
+		} `xml:"dependencies"`
+	} `xml:"dependencyManagement"`
 	Dependencies struct {
 		Text       string `xml:",chardata"`
 		Dependency []struct {
@@ -22,7 +50,7 @@ type Pom struct {
 		} `xml:"dependency"`
 	} `xml:"dependencies"`
 	Modules struct {
-		Module string `xml:"module"`
+		Module []string `xml:"module"`
 	} `xml:"modules,omitempty"`
 	Build struct {
 		Plugins struct {
@@ -40,6 +68,9 @@ type Pom struct {
 	ArtifactId string `xml:"artifactId"`
 	Profiles   struct {
 		Profile []struct {
+			Modules struct {
+				Module []string `xml:"module"`
+			} `xml:"modules,omitempty"`
 			Build struct {
 				Plugins struct {
 					Plugin []struct {
@@ -0,0 +1,29 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package schema
+
+type PyProjectToml struct {
+	Project struct {
+		Name         string   `toml:"name"`
+		Dependencies []string `toml:"dependencies"`
+	} `toml:"project"`
+	BuildSystem struct {
+		Requires     []string `toml:"requires"`
+		BuildBackend string   `toml:"build-backend"`
+	} `toml:"build-system"`
+	Tool struct {
+		Poetry map[string]interface{} `toml:"poetry"`
+		Pdm    map[string]interface{} `toml:"pdm"`
+		Hatch  map[string]interface{} `toml:"hatch"`
+		Uv     map[string]interface{} `toml:"uv"`
+	} `toml:"tool"`
+}
@@ -28,11 +28,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/BurntSushi/toml"
 	"github.com/devfile/alizer/pkg/apis/model"
 	"github.com/devfile/alizer/pkg/schema"
 	"github.com/devfile/alizer/pkg/utils/langfiles"
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
 	ignore "github.com/sabhiram/go-gitignore"
+	"gopkg.in/yaml.v3"
 )
 
 const FROM_PORT = 0
@@ -83,35 +85,41 @@ func IsTagInFile(file string, tag string) (bool, error) {
 	return strings.Contains(content, tag), nil
 }
 
-// IsTagInPomXMLFileArtifactId checks if a pom file contains the artifactId.
+// IsTagInPomXMLFileArtifactId checks if the effective pom contains the artifactId, as dependency, plugin or parent
+// (e.g. spring-boot-starter-parent). Managed dependencies are only versions made available to the modules, so they
+// are not taken into account.
 func IsTagInPomXMLFileArtifactId(pomFilePath, groupId, artifactId string) (bool, error) {
-	pom, err := GetPomFileContent(pomFilePath)
+	pom, err := GetEffectivePom(pomFilePath)
 	if err != nil {
 		return false, err
 	}
+	isArtifact := func(candidateGroupId, candidateArtifactId string) bool {
+		return strings.Contains(candidateArtifactId, artifactId) && strings.Contains(candidateGroupId, groupId)
+	}
 	for _, dependency := range pom.Dependencies.Dependency {
-		if strings.Contains(dependency.ArtifactId, artifactId) && strings.Contains(dependency.GroupId, groupId) {
+		if isArtifact(dependency.GroupId, dependency.ArtifactId) {
 			return true, nil
 		}
 	}
 	for _, plugin := range pom.Build.Plugins.Plugin {
-		if strings.Contains(plugin.ArtifactId, artifactId) && strings.Contains(plugin.GroupId, groupId) {
+		if isArtifact(plugin.GroupId, plugin.ArtifactId) {
 			return true, nil
 		}
 	}
 	for _, profile := range pom.Profiles.Profile {
 		for _, plugin := range profile.Build.Plugins.Plugin {
-			if strings.Contains(plugin.ArtifactId, artifactId) && strings.Contains(plugin.GroupId, groupId) {
+			if isArtifact(plugin.GroupId, plugin.ArtifactId) {
 				return true, nil
 			}
 		}
 	}
-	return false, nil
+	return isArtifact(pom.Parent.GroupId, pom.Parent.ArtifactId), nil
 }
 
-// IsTagInPomXMLFile checks if a pom file contains the tag.
+// IsTagInPomXMLFile checks if the effective pom contains the tag, as groupId of a dependency, plugin or parent.
+// Managed dependencies (e.g. an imported BOM) are not taken into account.
 func IsTagInPomXMLFile(pomFilePath string, tag string) (bool, error) {
-	pom, err := GetPomFileContent(pomFilePath)
+	pom, err := GetEffectivePom(pomFilePath)
 	if err != nil {
 		return false, err
 	}
@@ -125,7 +133,7 @@ func IsTagInPomXMLFile(pomFilePath string, tag string) (bool, error) {
 			return true, nil
 		}
 	}
-	return false, nil
+	return pom.Parent.GroupId != "" && strings.Contains(pom.Parent.GroupId, tag), nil
 }
 
 // GetPomFileContent returns the pom found in the path.
@@ -222,6 +230,118 @@ func GetComposerJsonSchemaFromFile(path string) (schema.ComposerJson, error) {
 	return composerJson, nil
 }
 
+// GetCargoTomlSchemaFromFile returns the Cargo.toml found in the path.
+func GetCargoTomlSchemaFromFile(path string) (schema.CargoToml, error) {
+	cleanPath := filepath.Clean(path)
+	bytes, err := os.ReadFile(cleanPath)
+	if err != nil {
+		return schema.CargoToml{}, err
+	}
+
+	var cargoToml schema.CargoToml
+	err = toml.Unmarshal(bytes, &cargoToml)
+	if err != nil {
+		return schema.CargoToml{}, err
+	}
+	return cargoToml, nil
+}
+
+// GetPyProjectTomlSchemaFromFile returns the pyproject.toml found in the path.
+func GetPyProjectTomlSchemaFromFile(path string) (schema.PyProjectToml, error) {
+	cleanPath := filepath.Clean(path)
+	bytes, err := os.ReadFile(cleanPath)
+	if err != nil {
+		return schema.PyProjectToml{}, err
+	}
+
+	var pyProjectToml schema.PyProjectToml
+	err = toml.Unmarshal(bytes, &pyProjectToml)
+	if err != nil {
+		return schema.PyProjectToml{}, err
+	}
+	return pyProjectToml, nil
+}
+
+// GetGradleVersionCatalogSchemaFromFile returns the Gradle version catalog found in the path.
+func GetGradleVersionCatalogSchemaFromFile(path string) (schema.GradleVersionCatalog, error) {
+	cleanPath := filepath.Clean(path)
+	bytes, err := os.ReadFile(cleanPath)
+	if err != nil {
+		return schema.GradleVersionCatalog{}, err
+	}
+
+	var versionCatalog schema.GradleVersionCatalog
+	err = toml.Unmarshal(bytes, &versionCatalog)
+	if err != nil {
+		return schema.GradleVersionCatalog{}, err
+	}
+	return versionCatalog, nil
+}
+
+// GetNxProjectJsonSchemaFromFile returns the Nx project.json found in the path.
+func GetNxProjectJsonSchemaFromFile(path string) (schema.NxProjectJson, error) {
+	cleanPath := filepath.Clean(path)
+	bytes, err := os.ReadFile(cleanPath)
+	if err != nil {
+		return schema.NxProjectJson{}, err
+	}
+
+	var projectJson schema.NxProjectJson
+	err = json.Unmarshal(bytes, &projectJson)
+	if err != nil {
+		return schema.NxProjectJson{}, err
+	}
+	return projectJson, nil
+}
+
+// GetLernaJsonSchemaFromFile returns the lerna.json found in the path.
+func GetLernaJsonSchemaFromFile(path string) (schema.LernaJson, error) {
+	cleanPath := filepath.Clean(path)
+	bytes, err := os.ReadFile(cleanPath)
+	if err != nil {
+		return schema.LernaJson{}, err
+	}
+
+	var lernaJson schema.LernaJson
+	err = json.Unmarshal(bytes, &lernaJson)
+	if err != nil {
+		return schema.LernaJson{}, err
+	}
+	return lernaJson, nil
+}
+
+// GetPnpmWorkspaceYamlSchemaFromFile returns the pnpm-workspace.yaml found in the path.
+func GetPnpmWorkspaceYamlSchemaFromFile(path string) (schema.PnpmWorkspaceYaml, error) {
+	cleanPath := filepath.Clean(path)
+	bytes, err := os.ReadFile(cleanPath)
+	if err != nil {
+		return schema.PnpmWorkspaceYaml{}, err
+	}
+
+	var pnpmWorkspaceYaml schema.PnpmWorkspaceYaml
+	err = yaml.Unmarshal(bytes, &pnpmWorkspaceYaml)
+	if err != nil {
+		return schema.PnpmWorkspaceYaml{}, err
+	}
+	return pnpmWorkspaceYaml, nil
+}
+
+// GetTsConfigJsonSchemaFromFile returns the tsconfig.json found in the path.
+func GetTsConfigJsonSchemaFromFile(path string) (schema.TsConfigJson, error) {
+	cleanPath := filepath.Clean(path)
+	bytes, err := os.ReadFile(cleanPath)
+	if err != nil {
+		return schema.TsConfigJson{}, err
+	}
+
+	var tsConfigJson schema.TsConfigJson
+	err = json.Unmarshal(bytes, &tsConfigJson)
+	if err != nil {
+		return schema.TsConfigJson{}, err
+	}
+	return tsConfigJson, nil
+}
+
 func AddToArrayIfValueExist(arr *[]string, val string) {
 	if val != "" {
 		*arr = append(*arr, val)
@@ -238,6 +358,26 @@ func Contains(s []string, str string) bool {
 	return false
 }
 
+// AppendPortIfMissing appends port to ports unless it has already been found
+func AppendPortIfMissing(ports []int, port int) []int {
+	for _, existing := range ports {
+		if existing == port {
+			return ports
+		}
+	}
+	return append(ports, port)
+}
+
+// IsVCSRoot checks if dir is the root of a git, Mercurial or Subversion repository
+func IsVCSRoot(dir string) bool {
+	for _, vcsDir := range []string{".git", ".hg", ".svn"} {
+		if _, err := os.Stat(filepath.Join(dir, vcsDir)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // GetFilePathsFromRoot walks the file tree starting from root and returns a slice of all file paths found.
 // Ignores files from .gitignore if it exists.
 func GetFilePathsFromRoot(root string) ([]string, error) {
@@ -487,6 +627,46 @@ func readEnvVarsFromDockerfile(file io.Reader) ([]model.EnvVar, error) {
 	return envVars, nil
 }
 
+// GetCommandsFromDockerfile returns the commands set with CMD and ENTRYPOINT instructions inside the Dockerfile of root.
+// Both the exec and the shell forms are returned as a single string.
+func GetCommandsFromDockerfile(root string) ([]string, error) {
+	locations := GetLocations(root)
+	for _, location := range locations {
+		filePath := filepath.Join(root, location)
+		cleanFilePath := filepath.Clean(filePath)
+		file, err := os.Open(cleanFilePath)
+		if err == nil {
+			defer CloseFile(file)
+			return readCommandsFromDockerfile(file)
+		}
+	}
+	return nil, fmt.Errorf("no dockefile found inside dir: %s", root)
+}
+
+// readCommandsFromDockerfile returns a slice of the CMD and ENTRYPOINT commands.
+func readCommandsFromDockerfile(file io.Reader) ([]string, error) {
+	var commands []string
+	res, err := parser.Parse(file)
+	if err != nil {
+		return commands, err
+	}
+
+	for _, child := range res.AST.Children {
+		instruction := strings.ToLower(child.Value)
+		if instruction != "cmd" && instruction != "entrypoint" {
+			continue
+		}
+		var args []string
+		for n := child.Next; n != nil; n = n.Next {
+			args = append(args, n.Value)
+		}
+		if len(args) > 0 {
+			commands = append(commands, strings.Join(args, " "))
+		}
+	}
+	return commands, nil
+}
+
 // GetEnvVarPortValueFromDockerfile gets port value defined as env vars.
 func GetEnvVarPortValueFromDockerfile(path string, portPlaceholders []string) ([]int, error) {
 	envVars, err := GetEnvVarsFromDockerFile(path)
@@ -731,6 +911,51 @@ func GetStringValueFromEnvFile(root string, regex string) string {
 	return ""
 }
 
+// GetEnvVarsFromDotEnvFiles returns the env vars declared inside the dotenv files of root.
+// The filenames are sorted from the highest to the lowest precedence, so a value read from a file
+// is not overridden by the ones read from the files that follow.
+func GetEnvVarsFromDotEnvFiles(root string, filenames []string) map[string]string {
+	envVars := map[string]string{}
+	for _, filename := range filenames {
+		bytes, err := os.ReadFile(filepath.Join(root, filename))
+		if err != nil {
+			continue
+		}
+		for name, value := range convertDotEnvFileToMap(bytes) {
+			if _, exists := envVars[name]; !exists {
+				envVars[name] = value
+			}
+		}
+	}
+	return envVars
+}
+
+// convertDotEnvFileToMap transforms the content of a dotenv file into a map.
+// Comments, the export keyword and the quotes around the values are removed.
+func convertDotEnvFileToMap(fileInBytes []byte) map[string]string {
+	envVars := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(fileInBytes))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, found := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if len(value) > 1 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		} else if comment := strings.Index(value, " #"); comment != -1 {
+			value = strings.TrimSpace(value[:comment])
+		}
+		envVars[name] = value
+	}
+	return envVars
+}
+
 // getEnvFileContent is exposed as a global variable for the purpose of running mock tests
 var getEnvFileContent = func(root string) (string, error) {
 	envPath := filepath.Join(root, ".env")
@@ -324,6 +324,48 @@ func Test_readEnvVarsFromDockerfile(t *testing.T) {
 	}
 }
 
+func Test_readCommandsFromDockerfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "case 1: dockerfile project with node command",
+			path: "../../resources/projects/dockerfile-simple/Dockerfile",
+			want: []string{"node server.js"},
+		},
+		{
+			name: "case 2: dockerfile project with uvicorn command",
+			path: "../../resources/projects/python-fastapi-docker-cmd/Dockerfile",
+			want: []string{"uvicorn main:app --host 0.0.0.0 --port 8002"},
+		},
+		{
+			name:    "case 3: not found project",
+			path:    "../../resources/projects/not-existing/Dockerfile",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanFilePath := filepath.Clean(tt.path)
+			file, err := os.Open(cleanFilePath)
+			if err != nil && !tt.wantErr {
+				t.Errorf("error: %s", err)
+			}
+			got, err := readCommandsFromDockerfile(file)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("readCommandsFromDockerfile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readCommandsFromDockerfile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetFilesByRegex(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -576,6 +618,18 @@ func TestIsTagInPomXMLFile(t *testing.T) {
 			expectedResult: false,
 			expectedError:  &missingFileErr,
 		},
+		{
+			name:           "Case 6: Matching tag of a dependency inherited from the local parent with an interpolated groupId",
+			pomFilePath:    "testdata/effective-pom/child/pom.xml",
+			tag:            "io.example.framework",
+			expectedResult: true,
+		},
+		{
+			name:           "Case 7: Ignoring tag of a managed dependency imported from a local BOM",
+			pomFilePath:    "testdata/effective-pom/child/pom.xml",
+			tag:            "io.example.platform",
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -607,6 +661,7 @@ func TestGetPomFileContent(t *testing.T) {
 			name:     "Case 1: Valid file",
 			filePath: "testdata/pom-dependency.xml",
 			expectedResult: schema.Pom{
+				Version: "1.0.0",
 				Dependencies: struct {
 					Text       string `xml:",chardata"`
 					Dependency []struct {
@@ -1428,6 +1483,54 @@ func TestGetPortValuesFromEnvFile(t *testing.T) {
 	}
 }
 
+func TestGetEnvVarsFromDotEnvFiles(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		".env":             "PORT=3000\nHOST=0.0.0.0\n# comment\nAPI_URL=\"http://localhost:8080\"\n",
+		".env.development": "export PORT=4000 # dev port\nDEBUG='true'\n",
+		".env.local":       "PORT=5000\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		filenames      []string
+		expectedResult map[string]string
+	}{
+		{
+			name:           "Case 1: single file",
+			filenames:      []string{".env"},
+			expectedResult: map[string]string{"PORT": "3000", "HOST": "0.0.0.0", "API_URL": "http://localhost:8080"},
+		},
+		{
+			name:           "Case 2: first file takes precedence",
+			filenames:      []string{".env.development", ".env"},
+			expectedResult: map[string]string{"PORT": "4000", "HOST": "0.0.0.0", "API_URL": "http://localhost:8080", "DEBUG": "true"},
+		},
+		{
+			name:           "Case 3: missing files are skipped",
+			filenames:      []string{".env.development.local", ".env.local", ".env"},
+			expectedResult: map[string]string{"PORT": "5000", "HOST": "0.0.0.0", "API_URL": "http://localhost:8080"},
+		},
+		{
+			name:           "Case 4: no file",
+			filenames:      []string{".env.production"},
+			expectedResult: map[string]string{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result := GetEnvVarsFromDotEnvFiles(root, tt.filenames)
+			assert.EqualValues(t, tt.expectedResult, result)
+		})
+	}
+}
+
 func TestGetStringValueFromEnvFile(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -1624,6 +1727,33 @@ func TestContains(t *testing.T) {
 	}
 }
 
+func TestAppendPortIfMissing(t *testing.T) {
+	tests := []struct {
+		name  string
+		ports []int
+		port  int
+		want  []int
+	}{
+		{
+			name:  "case 1: new port",
+			ports: []int{8080},
+			port:  8443,
+			want:  []int{8080, 8443},
+		},
+		{
+			name:  "case 2: port already found",
+			ports: []int{8080, 8443},
+			port:  8080,
+			want:  []int{8080, 8443},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AppendPortIfMissing(tt.ports, tt.port))
+		})
+	}
+}
+
 func TestGenerateApplicationFileFromFilters(t *testing.T) {
 	ctx := context.Background()
 	type args struct {
@@ -35,6 +35,8 @@ type LanguageItem struct {
 type LanguageFile struct {
 	languages           map[string]LanguageItem
 	extensionsXLanguage map[string][]LanguageItem
+	filenamesXLanguage  map[string][]LanguageItem
+	comments            schema.LanguagesComments
 }
 
 var (
@@ -54,6 +56,7 @@ func Get() *LanguageFile {
 func create() *LanguageFile {
 	languages := make(map[string]LanguageItem)
 	extensionsXLanguage := make(map[string][]LanguageItem)
+	filenamesXLanguage := make(map[string][]LanguageItem)
 
 	languagesProperties := getLanguagesProperties()
 
@@ -73,12 +76,17 @@ func create() *LanguageFile {
 				languagesByExtension = append(languagesByExtension, languageItem)
 				extensionsXLanguage[ext] = languagesByExtension
 			}
+			for _, filename := range properties.Filenames {
+				filenamesXLanguage[filename] = append(filenamesXLanguage[filename], languageItem)
+			}
 		}
 	}
 
 	return &LanguageFile{
 		languages:           languages,
 		extensionsXLanguage: extensionsXLanguage,
+		filenamesXLanguage:  filenamesXLanguage,
+		comments:            getLanguagesComments(),
 	}
 }
 
@@ -137,10 +145,35 @@ func getLanguageCustomizations() schema.LanguagesCustomizations {
 	return data
 }
 
+func getLanguagesComments() schema.LanguagesComments {
+	yamlFile, err := res.ReadFile("resources/languages-comments.yml")
+	if err != nil {
+		return schema.LanguagesComments{}
+	}
+
+	var data schema.LanguagesComments
+	err = yaml.Unmarshal(yamlFile, &data)
+	if err != nil {
+		return schema.LanguagesComments{}
+	}
+	return data
+}
+
 func (l *LanguageFile) GetLanguagesByExtension(extension string) []LanguageItem {
 	return l.extensionsXLanguage[extension]
 }
 
+// GetLanguagesByFilename returns the languages whose files are recognized by their exact name (e.g. Dockerfile, Makefile).
+func (l *LanguageFile) GetLanguagesByFilename(filename string) []LanguageItem {
+	return l.filenamesXLanguage[filename]
+}
+
+// GetLanguageComments returns the comment syntax of a language.
+// An empty value is returned for languages with unknown comment syntax.
+func (l *LanguageFile) GetLanguageComments(name string) schema.LanguageComments {
+	return l.comments[name]
+}
+
 func (l *LanguageFile) GetLanguageByName(name string) (LanguageItem, error) {
 	for langName, langItem := range l.languages {
 		if langName == name {
@@ -98,7 +98,12 @@ func TestGetLanguageByName(t *testing.T) {
 		},
 		{
 			name:         "Java",
-			expectedItem: LanguageItem{Name: "Java", Aliases: []string(nil), Kind: "programming", Group: "", ConfigurationFiles: []string{"pom.xml", "build.gradle"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
+			expectedItem: LanguageItem{Name: "Java", Aliases: []string(nil), Kind: "programming", Group: "", ConfigurationFiles: []string{"pom.xml", "build.gradle", "build.gradle.kts"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
+			expectedErr:  nil,
+		},
+		{
+			name:         "Kotlin",
+			expectedItem: LanguageItem{Name: "Kotlin", Aliases: []string(nil), Kind: "programming", Group: "", ConfigurationFiles: []string{"pom.xml", "build.gradle", "build.gradle.kts"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
 			expectedErr:  nil,
 		},
 		{
@@ -118,12 +123,17 @@ func TestGetLanguageByName(t *testing.T) {
 		},
 		{
 			name:         "JavaScript",
-			expectedItem: LanguageItem{Name: "JavaScript", Aliases: []string{"js", "node", "nodejs", "TypeScript"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"package.json"}, ExcludeFolders: []string{"node_modules"}, Component: true, disabled: false},
+			expectedItem: LanguageItem{Name: "JavaScript", Aliases: []string{"js", "node", "nodejs", "TypeScript"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"package.json", "^project\\.json$"}, ExcludeFolders: []string{"node_modules"}, Component: true, disabled: false},
 			expectedErr:  nil,
 		},
 		{
 			name:         "Python",
-			expectedItem: LanguageItem{Name: "Python", Aliases: []string{"python3", "rusthon"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"requirements.txt", "pyproject.toml"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
+			expectedItem: LanguageItem{Name: "Python", Aliases: []string{"python3", "rusthon"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"requirements.txt", "pyproject.toml", "^Pipfile$", "^setup\\.py$", "^environment\\.ya?ml$"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
+			expectedErr:  nil,
+		},
+		{
+			name:         "Ruby",
+			expectedItem: LanguageItem{Name: "Ruby", Aliases: []string{"jruby", "macruby", "rake", "rb", "rbx"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"^Gemfile$", ".*\\.gemspec$"}, ExcludeFolders: []string{"vendor"}, Component: true, disabled: false},
 			expectedErr:  nil,
 		},
 		{
@@ -131,6 +141,11 @@ func TestGetLanguageByName(t *testing.T) {
 			expectedItem: LanguageItem{Name: "Rust", Aliases: []string(nil), Kind: "programming", Group: "", ConfigurationFiles: []string{"Cargo.toml"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
 			expectedErr:  nil,
 		},
+		{
+			name:         "Scala",
+			expectedItem: LanguageItem{Name: "Scala", Aliases: []string(nil), Kind: "programming", Group: "", ConfigurationFiles: []string{"build.sbt"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
+			expectedErr:  nil,
+		},
 		{
 			name:         "PHP",
 			expectedItem: LanguageItem{Name: "PHP", Aliases: []string{"inc"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"composer.json", "package.json"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
@@ -199,13 +214,13 @@ func TestGetLanguageByAlias(t *testing.T) {
 		{
 			name:         "JavaScript",
 			alias:        "TypeScript",
-			expectedItem: LanguageItem{Name: "JavaScript", Aliases: []string{"js", "node", "nodejs", "TypeScript"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"package.json"}, ExcludeFolders: []string{"node_modules"}, Component: true, disabled: false},
+			expectedItem: LanguageItem{Name: "JavaScript", Aliases: []string{"js", "node", "nodejs", "TypeScript"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"package.json", "^project\\.json$"}, ExcludeFolders: []string{"node_modules"}, Component: true, disabled: false},
 			expectedErr:  nil,
 		},
 		{
 			name:         "Python",
 			alias:        "python3",
-			expectedItem: LanguageItem{Name: "Python", Aliases: []string{"python3", "rusthon"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"requirements.txt", "pyproject.toml"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
+			expectedItem: LanguageItem{Name: "Python", Aliases: []string{"python3", "rusthon"}, Kind: "programming", Group: "", ConfigurationFiles: []string{"requirements.txt", "pyproject.toml", "^Pipfile$", "^setup\\.py$", "^environment\\.ya?ml$"}, ExcludeFolders: []string(nil), Component: true, disabled: false},
 			expectedErr:  nil,
 		},
 		{
@@ -243,3 +258,52 @@ func TestGetExcludedFolders(t *testing.T) {
 	excludedFolders := languageFile.GetExcludedFolders()
 	assert.ElementsMatch(t, excludedFolders, expectedFolders)
 }
+
+func TestGetLanguagesByFilename(t *testing.T) {
+	tests := []struct {
+		name         string
+		filename     string
+		expectedSize int
+	}{
+		{
+			name:         "Not a language filename",
+			filename:     "notafilename",
+			expectedSize: 0,
+		},
+		{
+			name:         "Dockerfile",
+			filename:     "Dockerfile",
+			expectedSize: 1,
+		},
+		{
+			name:         "Makefile",
+			filename:     "Makefile",
+			expectedSize: 1,
+		},
+	}
+
+	languageFile := Get()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			languages := languageFile.GetLanguagesByFilename(tt.filename)
+			if len(languages) != tt.expectedSize {
+				t.Errorf("For filename %s, expected %d languages, but got %d", tt.filename, tt.expectedSize, len(languages))
+			}
+		})
+	}
+}
+
+func TestGetLanguageComments(t *testing.T) {
+	languageFile := Get()
+	goComments := languageFile.GetLanguageComments("Go")
+	assert.EqualValues(t, []string{"//"}, goComments.LineComments)
+	assert.Len(t, goComments.BlockComments, 1)
+
+	pythonComments := languageFile.GetLanguageComments("Python")
+	assert.EqualValues(t, []string{"#"}, pythonComments.LineComments)
+
+	unknownComments := languageFile.GetLanguageComments("Not a language")
+	assert.Empty(t, unknownComments.LineComments)
+	assert.Empty(t, unknownComments.BlockComments)
+}
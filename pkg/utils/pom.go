@@ -0,0 +1,158 @@
+/*******************************************************************************
+ * Copyright (c) 2026 Red Hat, Inc.
+ * Distributed under license by Red Hat, Inc. All rights reserved.
+ * This program is made available under the terms of the
+ * Eclipse Public License v2.0 which accompanies this distribution,
+ * and is available at http://www.eclipse.org/legal/epl-v20.html
+ *
+ * Contributors:
+ * Red Hat, Inc.
+ ******************************************************************************/
+
+package utils
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/devfile/alizer/pkg/schema"
+)
+
+// pomPropertyRegex matches the references to a property inside a pom, e.g. ${quarkus.platform.version}
+var pomPropertyRegex = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// GetEffectivePom returns the pom found in the path merged with the poms of its local parents. Parents are looked up
+// through their relativePath (../pom.xml by default). Dependencies, plugins, profiles and properties are inherited and
+// properties are interpolated. Remote parents are not resolved and, as managed dependencies are not used to detect
+// frameworks, imported BOMs are not resolved either.
+func GetEffectivePom(pomFilePath string) (schema.Pom, error) {
+	return getEffectivePom(pomFilePath, map[string]bool{})
+}
+
+func getEffectivePom(pomFilePath string, visited map[string]bool) (schema.Pom, error) {
+	absPomFilePath, err := filepath.Abs(pomFilePath)
+	if err != nil {
+		return schema.Pom{}, err
+	}
+	pom, err := GetPomFileContent(absPomFilePath)
+	if err != nil || visited[absPomFilePath] {
+		return pom, err
+	}
+	visited[absPomFilePath] = true
+
+	if parentPomFilePath := getLocalParentPomPath(absPomFilePath, pom); parentPomFilePath != "" {
+		if parentPom, err := getEffectivePom(parentPomFilePath, visited); err == nil {
+			pom = mergeParentPom(parentPom, pom)
+		}
+	}
+	if pom.GroupId == "" {
+		pom.GroupId = pom.Parent.GroupId
+	}
+	if pom.Version == "" {
+		pom.Version = pom.Parent.Version
+	}
+	interpolatePom(&pom, getPomProperties(pom))
+	return pom, nil
+}
+
+// getLocalParentPomPath returns the path of the parent pom if it can be found inside the source tree
+func getLocalParentPomPath(pomFilePath string, pom schema.Pom) string {
+	if pom.Parent.ArtifactId == "" {
+		return ""
+	}
+	relativePath := "../pom.xml"
+	if pom.Parent.RelativePath != nil {
+		relativePath = strings.TrimSpace(*pom.Parent.RelativePath)
+	}
+	if relativePath == "" {
+		return ""
+	}
+	parentPomFilePath := filepath.Join(filepath.Dir(pomFilePath), relativePath)
+	if !strings.HasSuffix(parentPomFilePath, ".xml") {
+		parentPomFilePath = filepath.Join(parentPomFilePath, "pom.xml")
+	}
+	parentPom, err := GetPomFileContent(parentPomFilePath)
+	if err != nil || parentPom.ArtifactId != pom.Parent.ArtifactId {
+		return ""
+	}
+	return parentPomFilePath
+}
+
+// mergeParentPom returns the child pom with the elements inherited from the parent pom
+func mergeParentPom(parent schema.Pom, child schema.Pom) schema.Pom {
+	if child.GroupId == "" {
+		child.GroupId = parent.GroupId
+	}
+	if child.Version == "" {
+		child.Version = parent.Version
+	}
+	child.Properties.Property = append(parent.Properties.Property, child.Properties.Property...)
+	child.Dependencies.Dependency = append(parent.Dependencies.Dependency, child.Dependencies.Dependency...)
+	child.Build.Plugins.Plugin = append(parent.Build.Plugins.Plugin, child.Build.Plugins.Plugin...)
+	// modules are not inherited
+	for _, profile := range parent.Profiles.Profile {
+		profile.Modules.Module = nil
+		child.Profiles.Profile = append(child.Profiles.Profile, profile)
+	}
+	return child
+}
+
+// getPomProperties returns the properties declared inside the pom, which override the inherited ones,
+// and the project properties (e.g. project.version)
+func getPomProperties(pom schema.Pom) map[string]string {
+	properties := map[string]string{}
+	for _, property := range pom.Properties.Property {
+		properties[property.XMLName.Local] = strings.TrimSpace(property.Value)
+	}
+	properties["project.groupId"] = pom.GroupId
+	properties["project.artifactId"] = pom.ArtifactId
+	properties["project.version"] = pom.Version
+	properties["project.parent.groupId"] = pom.Parent.GroupId
+	properties["project.parent.version"] = pom.Parent.Version
+	return properties
+}
+
+// interpolatePomValue replaces the references to the properties inside value. Properties can reference
+// other properties, so the replacement is repeated until nothing changes.
+func interpolatePomValue(value string, properties map[string]string) string {
+	for i := 0; i < 10 && strings.Contains(value, "${"); i++ {
+		interpolated := pomPropertyRegex.ReplaceAllStringFunc(value, func(reference string) string {
+			if property, found := properties[reference[2:len(reference)-1]]; found {
+				return property
+			}
+			return reference
+		})
+		if interpolated == value {
+			break
+		}
+		value = interpolated
+	}
+	return value
+}
+
+// interpolatePom replaces the references to properties inside the coordinates of dependencies and plugins
+func interpolatePom(pom *schema.Pom, properties map[string]string) {
+	for i := range pom.Dependencies.Dependency {
+		dependency := &pom.Dependencies.Dependency[i]
+		dependency.GroupId = interpolatePomValue(dependency.GroupId, properties)
+		dependency.ArtifactId = interpolatePomValue(dependency.ArtifactId, properties)
+		dependency.Version = interpolatePomValue(dependency.Version, properties)
+	}
+	for i := range pom.Build.Plugins.Plugin {
+		plugin := &pom.Build.Plugins.Plugin[i]
+		plugin.GroupId = interpolatePomValue(plugin.GroupId, properties)
+		plugin.ArtifactId = interpolatePomValue(plugin.ArtifactId, properties)
+		plugin.Version = interpolatePomValue(plugin.Version, properties)
+		plugin.Configuration.JavaOpts = interpolatePomValue(plugin.Configuration.JavaOpts, properties)
+	}
+	for i := range pom.Profiles.Profile {
+		for j := range pom.Profiles.Profile[i].Build.Plugins.Plugin {
+			plugin := &pom.Profiles.Profile[i].Build.Plugins.Plugin[j]
+			plugin.GroupId = interpolatePomValue(plugin.GroupId, properties)
+			plugin.ArtifactId = interpolatePomValue(plugin.ArtifactId, properties)
+			plugin.Version = interpolatePomValue(plugin.Version, properties)
+			plugin.Configuration.JavaOpts = interpolatePomValue(plugin.Configuration.JavaOpts, properties)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+var builder = WebApplication.CreateBuilder(args);
+
+// builder.WebHost.UseUrls("http://*:1234");
+builder.WebHost.ConfigureKestrel(options =>
+{
+    options.ListenAnyIP(5090);
+});
+
+var app = builder.Build();
+
+app.MapGet("/", () => "Hello World!");
+
+app.Run();
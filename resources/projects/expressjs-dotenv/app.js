@@ -0,0 +1,12 @@
+require('dotenv').config();
+const express = require('express');
+
+const app = express();
+
+app.get('/', (req, res) => {
+  res.send('Hello World!');
+});
+
+app.listen(process.env.TEST_EXPRESS_DOTENV_PORT, () => {
+  console.log(`App listening on port ${process.env.TEST_EXPRESS_DOTENV_PORT}`);
+});
@@ -0,0 +1,12 @@
+import express from 'express';
+
+const app = express();
+const port = Number(process.env.TEST_EXPRESS_ESM_PORT ?? 3021);
+
+app.get('/', (req, res) => {
+  res.send('Hello World!');
+});
+
+app.listen(port, () => {
+  console.log(`Server listening on port ${port}`);
+});
@@ -0,0 +1,12 @@
+import express, { Request, Response } from 'express';
+
+const app = express();
+const { PORT = 3020 } = process.env;
+
+app.get('/', (req: Request, res: Response) => {
+  res.send('Hello World!');
+});
+
+app.listen(PORT, () => {
+  console.log(`Server listening on port ${PORT}`);
+});
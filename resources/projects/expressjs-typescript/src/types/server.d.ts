@@ -0,0 +1,4 @@
+declare module 'server' {
+  // e.g. server.listen(9999)
+  export function listen(port: number): void;
+}
@@ -0,0 +1,5 @@
+import * as React from "react"
+
+const IndexPage = () => <main>Hello Gatsby</main>
+
+export default IndexPage
@@ -0,0 +1,25 @@
+package actions
+
+import (
+	"github.com/gobuffalo/buffalo"
+	"github.com/gobuffalo/envy"
+)
+
+// ENV is used to help switch settings based on where the application is being run
+var ENV = envy.Get("GO_ENV", "development")
+
+var app *buffalo.App
+
+// App is where all routes and middleware for buffalo are defined
+func App() *buffalo.App {
+	if app == nil {
+		app = buffalo.New(buffalo.Options{
+			Env:         ENV,
+			SessionName: "_coke_session",
+		})
+		app.GET("/", func(c buffalo.Context) error {
+			return c.Render(200, nil)
+		})
+	}
+	return app
+}
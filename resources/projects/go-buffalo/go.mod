@@ -0,0 +1,8 @@
+module github.com/acme/coke
+
+go 1.21
+
+require (
+	github.com/gobuffalo/buffalo v1.1.0
+	github.com/gobuffalo/envy v1.10.2
+)
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"log"
+
+	"github.com/acme/coke/actions"
+)
+
+func main() {
+	app := actions.App()
+	if err := app.Serve(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/example/buildignore/internal/version"
+)
+
+func main() {
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "version %s", version.Version)
+	})
+	log.Fatal(http.ListenAndServe(":8989", nil))
+}
@@ -0,0 +1,3 @@
+module github.com/example/buildignore
+
+go 1.21
@@ -0,0 +1,6 @@
+package version
+
+//go:generate go run ../../gen/gen.go
+
+// Version is the version of the server, written by gen/gen.go
+const Version = "0.1.0"
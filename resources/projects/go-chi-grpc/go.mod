@@ -0,0 +1,8 @@
+module github.com/acme/chi-grpc-gateway
+
+go 1.21
+
+require (
+	github.com/go-chi/chi/v5 v5.0.12
+	google.golang.org/grpc v1.61.0
+)
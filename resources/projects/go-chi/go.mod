@@ -0,0 +1,5 @@
+module github.com/acme/chi-bookstore
+
+go 1.21
+
+require github.com/go-chi/chi/v5 v5.0.12
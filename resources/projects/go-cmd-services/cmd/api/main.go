@@ -0,0 +1,7 @@
+package main
+
+import "github.com/acme/shipping/internal/httpserver"
+
+func main() {
+	httpserver.Start()
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/acme/shipping/internal/metrics"
+)
+
+func main() {
+	go metrics.Serve()
+	for range time.Tick(time.Minute) {
+		log.Println("dispatching shipments")
+	}
+}
@@ -0,0 +1,11 @@
+package debug
+
+import (
+	"net/http"
+	_ "net/http/pprof"
+)
+
+// Serve exposes the profiling endpoints, it is not used by any command
+func Serve() error {
+	return http.ListenAndServe("localhost:6060", nil)
+}
@@ -0,0 +1,15 @@
+package httpserver
+
+import (
+	"log"
+	"net/http"
+)
+
+// Start serves the shipping API
+func Start() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/shipments", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	log.Fatal(http.ListenAndServe(":8282", mux))
+}
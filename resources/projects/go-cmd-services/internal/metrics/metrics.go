@@ -0,0 +1,11 @@
+package metrics
+
+import (
+	"log"
+	"net/http"
+)
+
+// Serve exposes the metrics of the worker
+func Serve() {
+	log.Fatal(http.ListenAndServe(":9292", http.NotFoundHandler()))
+}
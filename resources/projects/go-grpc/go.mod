@@ -0,0 +1,5 @@
+module github.com/acme/greeter
+
+go 1.21
+
+require google.golang.org/grpc v1.61.0
@@ -0,0 +1,5 @@
+module github.com/acme/hertz-ping
+
+go 1.21
+
+require github.com/cloudwego/hertz v0.8.1
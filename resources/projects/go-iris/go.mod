@@ -0,0 +1,5 @@
+module github.com/acme/iris-todo
+
+go 1.21
+
+require github.com/kataras/iris/v12 v12.2.10
@@ -0,0 +1,11 @@
+package main
+
+import "github.com/kataras/iris/v12"
+
+func main() {
+	app := iris.New()
+	app.Get("/todos", func(ctx iris.Context) {
+		ctx.JSON([]string{})
+	})
+	app.Run(iris.Addr(":8787"))
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"github.com/acme/kratos-helloworld/internal/conf"
+	"github.com/acme/kratos-helloworld/internal/server"
+	"github.com/go-kratos/kratos/v2"
+)
+
+func main() {
+	c := &conf.Server{Http: &conf.Transport{}, Grpc: &conf.Transport{}}
+	app := kratos.New(
+		kratos.Name("helloworld"),
+		kratos.Server(server.NewHTTPServer(c)),
+	)
+	if err := app.Run(); err != nil {
+		panic(err)
+	}
+}
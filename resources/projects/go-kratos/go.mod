@@ -0,0 +1,8 @@
+module github.com/acme/kratos-helloworld
+
+go 1.21
+
+require (
+	github.com/go-kratos/kratos/v2 v2.7.2
+	google.golang.org/grpc v1.61.0
+)
@@ -0,0 +1,13 @@
+package conf
+
+// Server holds the addresses of the servers read from configs/config.yaml
+type Server struct {
+	Http *Transport
+	Grpc *Transport
+}
+
+// Transport is the configuration of a server
+type Transport struct {
+	Network string
+	Addr    string
+}
@@ -0,0 +1,15 @@
+package server
+
+import (
+	"github.com/acme/kratos-helloworld/internal/conf"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// NewHTTPServer creates the HTTP server
+func NewHTTPServer(c *conf.Server) *http.Server {
+	var opts []http.ServerOption
+	if c.Http.Addr != "" {
+		opts = append(opts, http.Address(c.Http.Addr))
+	}
+	return http.NewServer(opts...)
+}
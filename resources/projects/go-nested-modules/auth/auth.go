@@ -0,0 +1,19 @@
+package auth
+
+import "net/http"
+
+// Middleware rejects the requests without an Authorization header
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Serve runs the authentication service on its own
+func Serve() {
+	http.ListenAndServe(":9191", Middleware(http.NotFoundHandler()))
+}
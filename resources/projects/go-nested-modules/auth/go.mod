@@ -0,0 +1,3 @@
+module example.com/gateway/auth
+
+go 1.21
@@ -0,0 +1,7 @@
+module example.com/gateway
+
+go 1.21
+
+require example.com/gateway/auth v0.0.0
+
+replace example.com/gateway/auth => ./auth
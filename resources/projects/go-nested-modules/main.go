@@ -0,0 +1,7 @@
+package main
+
+import "example.com/gateway/server"
+
+func main() {
+	server.Start()
+}
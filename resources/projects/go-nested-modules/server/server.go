@@ -0,0 +1,13 @@
+package server
+
+import (
+	"log"
+	"net/http"
+
+	"example.com/gateway/auth"
+)
+
+// Start serves the gateway, authenticating every request
+func Start() {
+	log.Fatal(http.ListenAndServe(":9090", auth.Middleware(http.NotFoundHandler())))
+}
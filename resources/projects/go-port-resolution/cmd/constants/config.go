@@ -0,0 +1,6 @@
+package main
+
+const (
+	host = "0.0.0.0"
+	port = 8383
+)
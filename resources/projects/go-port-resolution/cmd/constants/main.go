@@ -0,0 +1,13 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+func main() {
+	// the previous version used http.ListenAndServe(":1111", nil)
+	addr := fmt.Sprintf("%s:%d", host, port)
+	log.Fatal(http.ListenAndServe(addr, nil))
+}
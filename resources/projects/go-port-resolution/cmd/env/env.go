@@ -0,0 +1,11 @@
+package main
+
+import "os"
+
+// getEnv returns the value of the env var or the fallback if it is not set
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"os"
+)
+
+func main() {
+	go func() {
+		log.Fatal(http.ListenAndServe(getEnv("SHIPPING_METRICS_ADDR", ":8586"), nil))
+	}()
+
+	port := os.Getenv("SHIPPING_PORT")
+	if port == "" {
+		port = "8585"
+	}
+	log.Fatal(http.ListenAndServe(":"+port, nil))
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+)
+
+func main() {
+	port := flag.Int("port", 8484, "port to listen on")
+	flag.Parse()
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", *port),
+		Handler: http.NotFoundHandler(),
+	}
+	log.Fatal(server.ListenAndServe())
+}
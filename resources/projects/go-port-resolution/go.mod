@@ -0,0 +1,3 @@
+module github.com/acme/ports
+
+go 1.21
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"log"
+
+	"github.com/acme/svc"
+)
+
+func main() {
+	log.Fatal(svc.Run())
+}
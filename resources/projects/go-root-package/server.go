@@ -0,0 +1,14 @@
+package svc
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Run starts the HTTP server of the service
+func Run() error {
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "svc")
+	})
+	return http.ListenAndServe(":8123", nil)
+}
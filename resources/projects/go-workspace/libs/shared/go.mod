@@ -0,0 +1,3 @@
+module github.com/acme/platform/shared
+
+go 1.21
@@ -0,0 +1,8 @@
+package shared
+
+import "fmt"
+
+// Greeting returns the message sent back by the services
+func Greeting(name string) string {
+	return fmt.Sprintf("Hello, %s!", name)
+}
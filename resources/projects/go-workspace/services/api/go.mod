@@ -0,0 +1,5 @@
+module github.com/acme/platform/api/v2
+
+go 1.21
+
+require github.com/acme/platform/shared v0.0.0
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/acme/platform/shared"
+)
+
+func main() {
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, shared.Greeting("api"))
+	})
+	log.Fatal(http.ListenAndServe(":8181", nil))
+}
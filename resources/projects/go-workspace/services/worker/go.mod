@@ -0,0 +1,7 @@
+module github.com/acme/platform/worker
+
+go 1.21
+
+require github.com/acme/platform/shared v0.0.0
+
+replace github.com/acme/platform/shared => ../../libs/shared
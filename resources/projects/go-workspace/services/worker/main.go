@@ -0,0 +1,14 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/acme/platform/shared"
+)
+
+func main() {
+	for range time.Tick(time.Minute) {
+		log.Println(shared.Greeting("worker"))
+	}
+}
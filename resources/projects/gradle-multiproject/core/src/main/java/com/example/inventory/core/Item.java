@@ -0,0 +1,4 @@
+package com.example.inventory.core;
+
+public record Item(String sku, int quantity) {
+}
@@ -0,0 +1,12 @@
+plugins {
+    kotlin("jvm") version "1.9.21"
+    application
+}
+
+dependencies {
+    implementation(project(":core"))
+}
+
+application {
+    mainClass.set("com.example.catalog.MainKt")
+}
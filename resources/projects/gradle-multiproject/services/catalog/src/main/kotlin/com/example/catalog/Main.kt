@@ -0,0 +1,5 @@
+package com.example.catalog
+
+fun main() {
+    println("Catalog service started")
+}
@@ -0,0 +1 @@
+rootProject.name = "gradle-version-catalog"
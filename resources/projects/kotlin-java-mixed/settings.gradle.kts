@@ -0,0 +1 @@
+rootProject.name = "kotlin-java-mixed"
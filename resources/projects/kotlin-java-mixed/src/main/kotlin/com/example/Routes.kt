@@ -0,0 +1,5 @@
+package com.example
+
+object Routes {
+    const val ROOT = "/"
+}
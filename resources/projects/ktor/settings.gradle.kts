@@ -0,0 +1 @@
+rootProject.name = "ktor-sample"
@@ -0,0 +1,8 @@
+const express = require('express');
+const { greet } = require('@lerna-demo/utils');
+
+const app = express();
+
+app.get('/', (req, res) => res.send(greet('lerna')));
+
+app.listen(3041);
@@ -0,0 +1 @@
+exports.greet = (name) => `Hello ${name}`;
@@ -0,0 +1,12 @@
+package com.example.effective;
+
+import org.springframework.boot.SpringApplication;
+import org.springframework.boot.autoconfigure.SpringBootApplication;
+
+@SpringBootApplication
+public class SpringServiceApplication {
+
+    public static void main(String[] args) {
+        SpringApplication.run(SpringServiceApplication.class, args);
+    }
+}
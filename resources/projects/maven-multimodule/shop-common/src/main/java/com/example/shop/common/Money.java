@@ -0,0 +1,6 @@
+package com.example.shop.common;
+
+import java.math.BigDecimal;
+
+public record Money(BigDecimal amount, String currency) {
+}
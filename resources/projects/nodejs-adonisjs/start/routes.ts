@@ -0,0 +1,7 @@
+import router from '@adonisjs/core/services/router'
+
+router.get('/', async () => {
+  return {
+    hello: 'world',
+  }
+})
@@ -0,0 +1,21 @@
+'use strict';
+
+const Hapi = require('@hapi/hapi');
+
+const init = async () => {
+  const server = Hapi.server({
+    port: 3008,
+    host: '0.0.0.0'
+  });
+
+  server.route({
+    method: 'GET',
+    path: '/',
+    handler: () => 'Hello World!'
+  });
+
+  await server.start();
+  console.log('Server running on %s', server.info.uri);
+};
+
+init();
@@ -0,0 +1,14 @@
+const Koa = require('koa');
+const Router = require('koa-router');
+
+const app = new Koa();
+const router = new Router();
+
+router.get('/', (ctx) => {
+  ctx.body = 'Hello Koa';
+});
+
+app.use(router.routes());
+
+const PORT = process.env.KOA_PORT || 3007;
+app.listen(PORT);
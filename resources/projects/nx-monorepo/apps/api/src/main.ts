@@ -0,0 +1,11 @@
+import express from 'express';
+
+const app = express();
+
+app.get('/api', (req, res) => {
+  res.send({ message: 'Welcome to api!' });
+});
+
+app.listen(3333, () => {
+  console.log('Listening at http://localhost:3333/api');
+});
@@ -0,0 +1,5 @@
+import { Button } from '@nx-monorepo/shared-ui';
+
+export default function Index() {
+  return <Button label="Welcome to web!" />;
+}
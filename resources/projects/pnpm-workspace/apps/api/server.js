@@ -0,0 +1,9 @@
+const express = require('express');
+const { log } = require('@acme/logger');
+
+const app = express();
+const port = process.env.TEST_PNPM_WORKSPACE_PORT || 3050;
+
+app.get('/', (req, res) => res.send('Hello from the api'));
+
+app.listen(port, () => log(`api listening on port ${port}`));
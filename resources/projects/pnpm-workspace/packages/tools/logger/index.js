@@ -0,0 +1 @@
+exports.log = (message) => console.log(`[acme] ${message}`);
@@ -0,0 +1,8 @@
+#!/bin/sh
+# waits for the database before starting the application
+until pg_isready --host "${DB_HOST:-db}" --port 5432
+do
+  echo "waiting for the database"
+  sleep 1
+done
+exec python main.py
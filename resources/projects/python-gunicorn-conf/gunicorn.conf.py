@@ -0,0 +1,5 @@
+import os
+
+bind = "0.0.0.0:" + os.environ.get("PORT", "8091")
+workers = 2
+accesslog = "-"
@@ -0,0 +1,12 @@
+from quart import Quart
+
+app = Quart(__name__)
+
+
+@app.route("/")
+async def hello():
+    return "hello"
+
+
+if __name__ == "__main__":
+    app.run(port=8086)
@@ -0,0 +1,16 @@
+import os
+
+from sanic import Sanic
+from sanic.response import text
+
+app = Sanic("HelloSanic")
+
+
+@app.get("/")
+async def hello_world(request):
+    return text("Hello, world.")
+
+
+if __name__ == "__main__":
+    port = int(os.environ.get("SANIC_APP_PORT", 8085))
+    app.run(host="0.0.0.0", port=port)
@@ -0,0 +1,8 @@
+from setuptools import find_packages, setup
+
+setup(
+    name="setuptools-app",
+    version="1.0.0",
+    packages=find_packages(),
+    install_requires=["flask>=3.0"],
+)
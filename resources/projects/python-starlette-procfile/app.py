@@ -0,0 +1,10 @@
+from starlette.applications import Starlette
+from starlette.responses import JSONResponse
+from starlette.routing import Route
+
+
+async def homepage(request):
+    return JSONResponse({"hello": "world"})
+
+
+app = Starlette(routes=[Route("/", homepage)])
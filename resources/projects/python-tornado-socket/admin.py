@@ -0,0 +1,11 @@
+import socket
+
+
+def serve_admin():
+    sock = socket.socket(socket.AF_INET, socket.SOCK_STREAM)
+    sock.bind(("127.0.0.1", 0))
+    sock.listen(5)
+    while True:
+        connection, _ = sock.accept()
+        connection.sendall(b"ok\n")
+        connection.close()
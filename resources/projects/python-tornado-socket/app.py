@@ -0,0 +1,30 @@
+import asyncio
+import socket
+
+import tornado.web
+from aiohttp_retry import ExponentialRetry, RetryClient
+
+
+class MainHandler(tornado.web.RequestHandler):
+    async def get(self):
+        async with RetryClient(retry_options=ExponentialRetry(attempts=3)) as client:
+            async with client.get("https://example.com") as response:
+                self.write(await response.text())
+
+
+def open_control_socket():
+    control = socket.socket(socket.AF_UNIX, socket.SOCK_STREAM)
+    control.bind("/tmp/app.sock")
+    control.listen(5)
+    return control
+
+
+async def main():
+    open_control_socket()
+    app = tornado.web.Application([(r"/", MainHandler)])
+    app.listen(8890)
+    await asyncio.Event().wait()
+
+
+if __name__ == "__main__":
+    asyncio.run(main())
@@ -0,0 +1,21 @@
+import asyncio
+
+import tornado.web
+from tornado.options import define, options
+
+define("port", default=8888, help="run on the given port", type=int)
+
+
+class MainHandler(tornado.web.RequestHandler):
+    def get(self):
+        self.write("Hello, world")
+
+
+async def main():
+    app = tornado.web.Application([(r"/", MainHandler)])
+    app.listen(options.port)
+    await asyncio.Event().wait()
+
+
+if __name__ == "__main__":
+    asyncio.run(main())
@@ -0,0 +1,12 @@
+import { defineConfig } from "vite";
+import { qwikVite } from "@builder.io/qwik/optimizer";
+import { qwikCity } from "@builder.io/qwik-city/vite";
+
+export default defineConfig(() => {
+  return {
+    plugins: [qwikCity(), qwikVite()],
+    server: {
+      port: 3012,
+    },
+  };
+});
@@ -0,0 +1,11 @@
+require_relative "boot"
+
+require "rails/all"
+
+Bundler.require(*Rails.groups)
+
+module RailsBlog
+  class Application < Rails::Application
+    config.load_defaults 7.1
+  end
+end
@@ -0,0 +1,11 @@
+require "sinatra/base"
+
+class Status < Sinatra::Base
+  set :port, 4570
+
+  get "/status" do
+    "ok"
+  end
+
+  run! if app_file == $0
+end
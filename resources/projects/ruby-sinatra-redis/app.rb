@@ -0,0 +1,14 @@
+require "redis"
+require "sinatra/base"
+
+class CounterApp < Sinatra::Base
+  configure do
+    set :redis, Redis.new(host: "localhost", port: 6379)
+  end
+
+  get "/" do
+    "Visits: #{settings.redis.incr("visits")}"
+  end
+
+  run! port: 4568 if app_file == $0
+end
@@ -0,0 +1,13 @@
+use std::env;
+use warp::Filter;
+
+#[tokio::main]
+async fn main() {
+    let hello = warp::path!("hello" / String).map(|name| format!("Hello, {}!", name));
+
+    let port: u16 = env::var("WARP_PORT")
+        .ok()
+        .and_then(|port| port.parse().ok())
+        .unwrap_or(3030);
+    warp::serve(hello).run(([0, 0, 0, 0], port)).await;
+}
@@ -0,0 +1,12 @@
+use std::net::SocketAddr;
+
+use axum::{routing::get, Router};
+
+#[tokio::main]
+async fn main() {
+    let app = Router::new().route("/", get(|| async { workspace_core::greeting() }));
+
+    let addr = SocketAddr::from(([0, 0, 0, 0], 4000));
+    let listener = tokio::net::TcpListener::bind(addr).await.unwrap();
+    axum::serve(listener, app).await.unwrap();
+}
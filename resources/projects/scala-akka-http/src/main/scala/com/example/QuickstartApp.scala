@@ -0,0 +1,20 @@
+package com.example
+
+import akka.actor.typed.ActorSystem
+import akka.actor.typed.scaladsl.Behaviors
+import akka.http.scaladsl.Http
+import akka.http.scaladsl.server.Directives._
+
+object QuickstartApp {
+  def main(args: Array[String]): Unit = {
+    implicit val system: ActorSystem[Nothing] = ActorSystem(Behaviors.empty, "quickstart")
+
+    val route = path("hello") {
+      get {
+        complete("Hello world!")
+      }
+    }
+
+    Http().newServerAt("0.0.0.0", 8080).bind(route)
+  }
+}
@@ -0,0 +1,20 @@
+package com.example
+
+import cats.effect.{IO, IOApp}
+import com.comcast.ip4s._
+import org.http4s.HttpRoutes
+import org.http4s.dsl.io._
+import org.http4s.ember.server.EmberServerBuilder
+
+object Main extends IOApp.Simple {
+  val helloRoutes = HttpRoutes.of[IO] {
+    case GET -> Root / "hello" => Ok("Hello world!")
+  }
+
+  val run = EmberServerBuilder.default[IO]
+    .withHost(ipv4"0.0.0.0")
+    .withPort(port"8282")
+    .withHttpApp(helloRoutes.orNotFound)
+    .build
+    .useForever
+}
@@ -0,0 +1,18 @@
+package com.example
+
+import org.apache.pekko.actor.ActorSystem
+import org.apache.pekko.http.scaladsl.Http
+import org.apache.pekko.http.scaladsl.server.Directives._
+
+object Main extends App {
+  implicit val system: ActorSystem = ActorSystem("hello")
+
+  val route = path("hello") {
+    get {
+      complete("Hello world!")
+    }
+  }
+
+  val port = sys.env.getOrElse("HELLO_PEKKO_PORT", "8787").toInt
+  Http().bindAndHandle(route, "0.0.0.0", port)
+}
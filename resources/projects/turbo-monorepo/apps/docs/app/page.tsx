@@ -0,0 +1,9 @@
+import { Button } from "@repo/ui/button";
+
+export default function Page(): JSX.Element {
+  return (
+    <main>
+      <Button appName="docs">Open alert</Button>
+    </main>
+  );
+}
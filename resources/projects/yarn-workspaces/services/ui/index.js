@@ -0,0 +1 @@
+export const title = (text) => `<h1>${text}</h1>`;
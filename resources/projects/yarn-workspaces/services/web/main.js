@@ -0,0 +1,3 @@
+import { title } from '@yarn-ws/ui';
+
+document.querySelector('#app').innerHTML = title('Hello Vite!');
@@ -35,15 +35,49 @@ func TestComponentDetectionOnVBNet(t *testing.T) {
 }
 
 // port detection: dotnet
-// not supported
+func TestPortDetectionDotNetLaunchSettings(t *testing.T) {
+	testPortDetectionInProject(t, "s2i-dotnetcore-ex", []int{7008, 5247})
+}
+
+func TestPortDetectionFSharpLaunchSettings(t *testing.T) {
+	testPortDetectionInProject(t, "net-fsharp", []int{5001, 5000})
+}
+
+func TestPortDetectionDotNetKestrelEndpoints(t *testing.T) {
+	// Kestrel endpoints take precedence over launchSettings.json
+	testPortDetectionInProject(t, "dotnet-kestrel-endpoints", []int{5080, 5443})
+}
+
+func TestPortDetectionDotNetCommentedSettings(t *testing.T) {
+	// settings files may contain comments and trailing commas
+	testPortDetectionInProject(t, "dotnet-commented-settings", []int{5180})
+}
+
+func TestPortDetectionDotNetProgramUrls(t *testing.T) {
+	testPortDetectionInProject(t, "dotnet-program-urls", []int{5090})
+}
+
+func TestPortDetectionDotNetEnvUrlsInDockerfile(t *testing.T) {
+	testPortDetectionInProject(t, "dotnet-env-urls", []int{8085})
+}
+
+func TestPortDetectionDotNetFromEnvs(t *testing.T) {
+	os.Setenv("ASPNETCORE_URLS", "http://+:8090;https://+:8091")
+	testPortDetectionInProject(t, "dotnet-env-urls", []int{8090, 8091})
+	os.Unsetenv("ASPNETCORE_URLS")
+}
 
 // component detection: go
 func TestComponentDetectionOnBeego(t *testing.T) {
-	isComponentsInProject(t, "beego", 1, "Go", "beego")
+	// the module only holds the config/loadConfig and config/webAutoLoadConfig main packages, so each of them
+	// is a component reading conf/app.conf from the module root and no component is named after the module
+	isComponentsInProject(t, "beego", 2, "Go", "loadConfig")
+	testWorkspaceComponentInProject(t, "beego", "loadConfig", false, nil, []int{1999})
+	testWorkspaceComponentInProject(t, "beego", "webAutoLoadConfig", false, nil, []int{1999})
 }
 
 func TestComponentDetectionOnEcho(t *testing.T) {
-	isComponentsInProject(t, "echo", 1, "Go", "echo")
+	isComponentsInProject(t, "echo", 1, "Go", "golang-echo-realworld-example-app")
 }
 
 func TestComponentDetectionOnFastHTTP(t *testing.T) {
@@ -51,18 +85,101 @@ func TestComponentDetectionOnFastHTTP(t *testing.T) {
 }
 
 func TestComponentDetectionOnGin(t *testing.T) {
-	isComponentsInProject(t, "golang-gin-app", 1, "Go", "golang-gin-app")
+	isComponentsInProject(t, "golang-gin-app", 1, "Go", "golang-gin-realworld-example-app")
 }
 
 func TestComponentDetectionOnFiber(t *testing.T) {
-	isComponentsInProject(t, "golang-fiber", 1, "Go", "golang-fiber")
+	isComponentsInProject(t, "golang-fiber", 1, "Go", "projectgofiber")
 }
 
 func TestComponentDetectionOnMux(t *testing.T) {
-	isComponentsInProject(t, "golang-mux", 1, "Go", "golang-mux")
+	isComponentsInProject(t, "golang-mux", 1, "Go", "projectgomux")
+}
+
+func TestComponentDetectionOnChi(t *testing.T) {
+	isComponentsInProject(t, "go-chi", 1, "Go", "chi-bookstore")
+}
+
+func TestComponentDetectionOnIris(t *testing.T) {
+	isComponentsInProject(t, "go-iris", 1, "Go", "iris-todo")
+}
+
+func TestComponentDetectionOnHertz(t *testing.T) {
+	isComponentsInProject(t, "go-hertz", 1, "Go", "hertz-ping")
+}
+
+func TestComponentDetectionOnKratos(t *testing.T) {
+	isComponentsInProject(t, "go-kratos", 1, "Go", "kratos-helloworld")
+}
+
+func TestComponentDetectionOnBuffalo(t *testing.T) {
+	isComponentsInProject(t, "go-buffalo", 1, "Go", "coke")
+}
+
+func TestComponentDetectionOnGrpc(t *testing.T) {
+	isComponentsInProject(t, "go-grpc", 1, "Go", "greeter")
+}
+
+func TestComponentDetectionOnChiWithGrpc(t *testing.T) {
+	isComponentsInProject(t, "go-chi-grpc", 1, "Go", "chi-grpc-gateway")
+}
+
+func TestComponentDetectionOnGoWorkspace(t *testing.T) {
+	// each module used by go.work is a component, named after the last element of its module path
+	isComponentsInProject(t, "go-workspace", 3, "Go", "")
+	testWorkspaceComponentInProject(t, "go-workspace", "api", false, []string{"shared"}, []int{8181})
+	testWorkspaceComponentInProject(t, "go-workspace", "worker", false, []string{"shared"}, nil)
+	testWorkspaceComponentInProject(t, "go-workspace", "shared", false, nil, nil)
+}
+
+func TestComponentDetectionOnGoNestedModules(t *testing.T) {
+	// the files of the nested auth module are not part of the gateway component
+	isComponentsInProject(t, "go-nested-modules", 2, "Go", "")
+	testWorkspaceComponentInProject(t, "go-nested-modules", "gateway", false, []string{"auth"}, []int{9090})
+	testWorkspaceComponentInProject(t, "go-nested-modules", "auth", false, nil, []int{9191})
+}
+
+func TestComponentDetectionOnGoCmdServices(t *testing.T) {
+	// ports are only detected inside the packages imported by each main package
+	isComponentsInProject(t, "go-cmd-services", 2, "Go", "api")
+	testWorkspaceComponentInProject(t, "go-cmd-services", "api", false, nil, []int{8282})
+	testWorkspaceComponentInProject(t, "go-cmd-services", "worker", false, nil, []int{9292})
+}
+
+func TestComponentDetectionOnGoModuleInsideTestdataFolder(t *testing.T) {
+	// only the testdata folders inside the module are ignored, not the folders above it
+	projectPath := filepath.Join(t.TempDir(), "testdata", "go-cmd-services")
+	if err := os.CopyFS(projectPath, os.DirFS(getTestProjectPath("go-cmd-services"))); err != nil {
+		t.Fatal(err)
+	}
+	components := getComponentsFromProjectInner(t, projectPath)
+	verifyComponents(t, components, 2, "Go", "api")
+	for _, component := range components {
+		if component.Name == "api" && (len(component.Ports) != 1 || component.Ports[0] != 8282) {
+			t.Errorf("Expected port 8282 for component api but found %v", component.Ports)
+		}
+	}
+}
+
+func TestComponentDetectionOnGoBuildIgnore(t *testing.T) {
+	// gen/gen.go is a main package excluded by //go:build ignore, so the module has a single main package
+	isComponentsInProject(t, "go-build-ignore", 1, "Go", "buildignore")
+}
+
+func TestComponentDetectionOnGoRootPackage(t *testing.T) {
+	isComponentsInProject(t, "go-root-package", 1, "Go", "svc")
 }
 
 // port detection: go
+func TestPortDetectionGoRootPackage(t *testing.T) {
+	// the main package imports the package at the root of the module, which starts the server
+	testPortDetectionInProject(t, "go-root-package", []int{8123})
+}
+
+func TestPortDetectionGoBuildIgnore(t *testing.T) {
+	testPortDetectionInProject(t, "go-build-ignore", []int{8989})
+}
+
 func TestPortDetectionGoBeego(t *testing.T) {
 	testPortDetectionInProject(t, "beego", []int{1999})
 }
@@ -91,6 +208,189 @@ func TestPortDetectionGoFiber(t *testing.T) {
 	testPortDetectionInProject(t, "golang-fiber", []int{3000})
 }
 
+func TestPortDetectionGoChi(t *testing.T) {
+	testPortDetectionInProject(t, "go-chi", []int{3333})
+}
+
+func TestPortDetectionGoIris(t *testing.T) {
+	testPortDetectionInProject(t, "go-iris", []int{8787})
+}
+
+func TestPortDetectionGoHertz(t *testing.T) {
+	testPortDetectionInProject(t, "go-hertz", []int{8686})
+}
+
+func TestPortDetectionGoKratos(t *testing.T) {
+	testPortDetectionInProject(t, "go-kratos", []int{8000, 9000})
+}
+
+func TestPortDetectionGoBuffalo(t *testing.T) {
+	testPortDetectionInProject(t, "go-buffalo", []int{3030})
+}
+
+func TestPortDetectionGoGrpc(t *testing.T) {
+	testPortDetectionInProject(t, "go-grpc", []int{50051})
+}
+
+func TestPortDetectionGoChiWithGrpc(t *testing.T) {
+	// the ports found by the detectors of all the frameworks are merged
+	testPortDetectionInProject(t, "go-chi-grpc", []int{3334, 50052})
+}
+
+func TestPortDetectionGoConstants(t *testing.T) {
+	// the port is built with fmt.Sprintf from constants declared in another file of the package
+	testWorkspaceComponentInProject(t, "go-port-resolution", "constants", false, nil, []int{8383})
+}
+
+func TestPortDetectionGoFlags(t *testing.T) {
+	testWorkspaceComponentInProject(t, "go-port-resolution", "flags", false, nil, []int{8484})
+}
+
+func TestPortDetectionGoEnvFallbacks(t *testing.T) {
+	testWorkspaceComponentInProject(t, "go-port-resolution", "env", false, nil, []int{8585, 8586})
+}
+
+func TestPortDetectionGoFromEnvs(t *testing.T) {
+	os.Setenv("SHIPPING_PORT", "9595")
+	os.Setenv("SHIPPING_METRICS_ADDR", "0.0.0.0:9596")
+	testWorkspaceComponentInProject(t, "go-port-resolution", "env", false, nil, []int{9595, 9596})
+	os.Unsetenv("SHIPPING_PORT")
+	os.Unsetenv("SHIPPING_METRICS_ADDR")
+}
+
+// component detection: ruby
+func TestComponentDetectionOnRails(t *testing.T) {
+	isComponentsInProject(t, "ruby-rails", 1, "Ruby", "rails_blog")
+}
+
+func TestComponentDetectionOnSinatra(t *testing.T) {
+	isComponentsInProject(t, "ruby-sinatra", 1, "Ruby", "ruby-sinatra")
+}
+
+func TestComponentDetectionOnHanami(t *testing.T) {
+	isComponentsInProject(t, "ruby-hanami", 1, "Ruby", "bookshelf")
+}
+
+// port detection: ruby
+func TestPortDetectionRubyRails(t *testing.T) {
+	testPortDetectionInProject(t, "ruby-rails", []int{3000})
+}
+
+func TestPortDetectionRubySinatra(t *testing.T) {
+	testPortDetectionInProject(t, "ruby-sinatra", []int{4567})
+}
+
+func TestPortDetectionRubySinatraIgnoresClientPorts(t *testing.T) {
+	testPortDetectionInProject(t, "ruby-sinatra-redis", []int{4568})
+}
+
+func TestPortDetectionRubySinatraGem(t *testing.T) {
+	isComponentsInProject(t, "ruby-sinatra-gem", 1, "Ruby", "sinatra-status")
+	testPortDetectionInProject(t, "ruby-sinatra-gem", []int{4570})
+}
+
+func TestPortDetectionRubyHanami(t *testing.T) {
+	testPortDetectionInProject(t, "ruby-hanami", []int{2300})
+}
+
+// component detection: rust
+func TestComponentDetectionOnActix(t *testing.T) {
+	isComponentsInProject(t, "rust-actix", 1, "Rust", "actix-hello")
+}
+
+func TestComponentDetectionOnAxum(t *testing.T) {
+	isComponentsInProject(t, "rust-axum", 1, "Rust", "axum-hello")
+}
+
+func TestComponentDetectionOnRocket(t *testing.T) {
+	isComponentsInProject(t, "rust-rocket", 1, "Rust", "rocket-hello")
+}
+
+func TestComponentDetectionOnWarp(t *testing.T) {
+	isComponentsInProject(t, "rust-warp", 1, "Rust", "warp-hello")
+}
+
+func TestComponentDetectionOnRustWithoutFramework(t *testing.T) {
+	isComponentsInProject(t, "rust-std", 1, "Rust", "std-hello")
+}
+
+func TestComponentDetectionOnCargoWorkspace(t *testing.T) {
+	isComponentsInProject(t, "rust-workspace", 2, "Rust", "workspace-api")
+}
+
+// port detection: rust
+func TestPortDetectionRustActix(t *testing.T) {
+	testPortDetectionInProject(t, "rust-actix", []int{8080})
+}
+
+func TestPortDetectionRustAxum(t *testing.T) {
+	testPortDetectionInProject(t, "rust-axum", []int{3000})
+}
+
+func TestPortDetectionRustRocket(t *testing.T) {
+	testPortDetectionInProject(t, "rust-rocket", []int{8000})
+}
+
+func TestPortDetectionRustWarp(t *testing.T) {
+	testPortDetectionInProject(t, "rust-warp", []int{3030})
+}
+
+func TestPortDetectionRustWithoutFramework(t *testing.T) {
+	testPortDetectionInProject(t, "rust-std", []int{8080})
+}
+
+func TestPortDetectionRustCargoWorkspace(t *testing.T) {
+	testPortDetectionInProject(t, "rust-workspace", []int{4000})
+}
+
+// component detection: scala
+func TestComponentDetectionOnPlay(t *testing.T) {
+	isComponentsInProject(t, "scala-play", 1, "Scala", "play-scala-hello")
+}
+
+func TestComponentDetectionOnAkkaHttp(t *testing.T) {
+	isComponentsInProject(t, "scala-akka-http", 1, "Scala", "akka-http-quickstart")
+}
+
+func TestComponentDetectionOnPekkoHttp(t *testing.T) {
+	isComponentsInProject(t, "scala-pekko-http", 1, "Scala", "pekko-http-hello")
+}
+
+func TestComponentDetectionOnHttp4s(t *testing.T) {
+	isComponentsInProject(t, "scala-http4s", 1, "Scala", "http4s-quickstart")
+}
+
+// port detection: scala
+func TestPortDetectionScalaPlay(t *testing.T) {
+	testPortDetectionInProject(t, "scala-play", []int{9005})
+}
+
+func TestPortDetectionScalaAkkaHttp(t *testing.T) {
+	testPortDetectionInProject(t, "scala-akka-http", []int{8080})
+}
+
+func TestPortDetectionScalaPekkoHttp(t *testing.T) {
+	testPortDetectionInProject(t, "scala-pekko-http", []int{8787})
+}
+
+func TestPortDetectionScalaHttp4s(t *testing.T) {
+	testPortDetectionInProject(t, "scala-http4s", []int{8282})
+}
+
+func TestPortDetectionScalaAkkaHttpSamePortTwice(t *testing.T) {
+	// the port bound by both bindAndHandle and newServerAt is reported once
+	projectPath := filepath.Join(t.TempDir(), "scala-akka-http")
+	if err := os.CopyFS(projectPath, os.DirFS(getTestProjectPath("scala-akka-http"))); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(projectPath, "src", "main", "scala", "com", "example"), "QuickstartApp.scala",
+		"object QuickstartApp {\n  val port = 8084\n  Http().bindAndHandle(route, \"0.0.0.0\", port)\n  Http().newServerAt(\"0.0.0.0\", port).bind(route)\n}\n")
+	components := getComponentsFromProjectInner(t, projectPath)
+	if len(components) != 1 || len(components[0].Ports) != 1 || components[0].Ports[0] != 8084 {
+		t.Errorf("Expected a component with port 8084 but found %v", components)
+	}
+}
+
 // component detection: java
 func TestComponentDetectionOnJBossEAPByEAPMavenPlugin(t *testing.T) {
 	isComponentsInProject(t, "jboss-eap-by-eap-maven-plugin", 1, "java", "jboss-eap-by-eap-maven-plugin")
@@ -156,6 +456,53 @@ func TestComponentDetectionOnJakartaEE(t *testing.T) {
 	isComponentsInProject(t, "jakartaee", 1, "java", "jakartaee-app")
 }
 
+func TestComponentDetectionOnMavenMultiModule(t *testing.T) {
+	isComponentsInProject(t, "maven-multimodule", 3, "java", "shop-api")
+	testWorkspaceComponentInProject(t, "maven-multimodule", "shop-api", false, []string{"shop-common"}, []int{8095})
+	testWorkspaceComponentInProject(t, "maven-multimodule", "shop-common", true, nil, nil)
+	// shop-web is declared as module inside a profile
+	testWorkspaceComponentInProject(t, "maven-multimodule", "shop-web", false, []string{"shop-common"}, nil)
+	testParentOfComponentsInProject(t, "maven-multimodule", "shop-parent")
+}
+
+func TestComponentDetectionOnGradleMultiProject(t *testing.T) {
+	// the root and the core library subproject are not components
+	isComponentsInProject(t, "gradle-multiproject", 2, "java", "app")
+}
+
+func TestComponentDetectionOnGradleProjectInsideRepository(t *testing.T) {
+	// the settings file above the root of the repository does not make the library a subproject
+	workspace := t.TempDir()
+	writeTestFile(t, workspace, "settings.gradle", "include 'shared-lib'\n")
+	projectPath := filepath.Join(workspace, "shared-lib")
+	if err := os.MkdirAll(filepath.Join(projectPath, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, projectPath, "build.gradle", "plugins {\n    id 'java-library'\n}\n")
+	writeTestFile(t, projectPath, "Lib.java", "public class Lib {}\n")
+	verifyComponents(t, getComponentsFromProjectInner(t, projectPath), 1, "java", "shared-lib")
+}
+
+func TestComponentDetectionOnGradleVersionCatalog(t *testing.T) {
+	isComponentsInProject(t, "gradle-version-catalog", 1, "java", "gradle-version-catalog")
+}
+
+func TestComponentDetectionOnMavenEffectivePom(t *testing.T) {
+	isComponentsInProject(t, "maven-effective-pom", 2, "java", "quarkus-service")
+}
+
+func TestComponentDetectionOnKtor(t *testing.T) {
+	isComponentsInProject(t, "ktor", 1, "Kotlin", "ktor-sample")
+}
+
+func TestComponentDetectionOnKotlinMixedWithJava(t *testing.T) {
+	isComponentsInProject(t, "kotlin-java-mixed", 1, "Kotlin", "kotlin-java-mixed")
+}
+
+func TestComponentDetectionOnKtorWithMaven(t *testing.T) {
+	isComponentsInProject(t, "ktor-application-conf", 1, "Kotlin", "ktor-application-conf")
+}
+
 // port detection: java
 func TestPortDetectionJavaJBossEAP(t *testing.T) {
 	testPortDetectionInProject(t, "jboss-eap-by-eap-maven-plugin", []int{8380})
@@ -226,6 +573,21 @@ func TestPortDetectionSpring(t *testing.T) {
 	testPortDetectionInProject(t, "spring", []int{9012})
 }
 
+func TestPortDetectionGradleMultiProject(t *testing.T) {
+	testPortDetectionInProject(t, "gradle-multiproject", []int{8096})
+}
+
+func TestPortDetectionGradleVersionCatalog(t *testing.T) {
+	testPortDetectionInProject(t, "gradle-version-catalog", []int{8099})
+}
+
+func TestPortDetectionMavenEffectivePom(t *testing.T) {
+	// Quarkus is declared with interpolated plugin coordinates, the BOM imported from the source tree is not enough
+	testWorkspaceComponentInProject(t, "maven-effective-pom", "quarkus-service", false, nil, []int{8097})
+	// Spring Boot is declared with an interpolated groupId inside a parent found through its relativePath
+	testWorkspaceComponentInProject(t, "maven-effective-pom", "spring-service", false, nil, []int{8098})
+}
+
 func TestPortDetectionSpringDockerfileSimple(t *testing.T) {
 	testPortDetectionInProject(t, "spring-dockerfile-simple", []int{1345})
 }
@@ -242,6 +604,32 @@ func TestPortDetectionJavaWildfly(t *testing.T) {
 	testPortDetectionInProject(t, "wildfly", []int{8085})
 }
 
+func TestPortDetectionKtor(t *testing.T) {
+	testPortDetectionInProject(t, "ktor", []int{8090})
+}
+
+func TestPortDetectionKtorApplicationConf(t *testing.T) {
+	testPortDetectionInProject(t, "ktor-application-conf", []int{8181})
+}
+
+func TestPortDetectionKtorApplicationConfWithDatabase(t *testing.T) {
+	// only ktor.deployment.port is the port of the application, not the port of the database block
+	testPortDetectionInProject(t, "ktor-conf-database", []int{8182})
+}
+
+func TestPortDetectionKtorApplicationConfDottedKey(t *testing.T) {
+	projectPath := filepath.Join(t.TempDir(), "ktor-conf-database")
+	if err := os.CopyFS(projectPath, os.DirFS(getTestProjectPath("ktor-conf-database"))); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(projectPath, "src", "main", "resources"), "application.conf",
+		"database.port = 5432\nktor.deployment.port = 8183\nktor.application.modules = [ com.example.ApplicationKt.module ]\n")
+	components := getComponentsFromProjectInner(t, projectPath)
+	if len(components) != 1 || len(components[0].Ports) != 1 || components[0].Ports[0] != 8183 {
+		t.Errorf("Expected a component with port 8183 but found %v", components)
+	}
+}
+
 // component detection: javascript, typescript
 func TestComponentDetectionOnJavascript(t *testing.T) {
 	isComponentsInProject(t, "nodejs-ex", 1, "javascript", "nodejs-starter")
@@ -255,6 +643,14 @@ func TestComponentDetectionOnExpress(t *testing.T) {
 	isComponentsInProject(t, "expressjs", 1, "javascript", "expressjs")
 }
 
+func TestComponentDetectionOnNestJs(t *testing.T) {
+	isComponentsInProject(t, "nodejs-nestjs", 1, "typescript", "nest-app")
+}
+
+func TestComponentDetectionOnFastify(t *testing.T) {
+	isComponentsInProject(t, "nodejs-fastify", 1, "javascript", "fastify-app")
+}
+
 func TestComponentDetectionOnNextJs(t *testing.T) {
 	isComponentsInProject(t, "nextjs-app", 1, "typescript", "nextjs-app")
 }
@@ -314,6 +710,160 @@ func TestPortDetectionJavascriptExpressDockerfileEnvVar(t *testing.T) {
 	testPortDetectionInProject(t, "expressjs-dockerfile-env", []int{1345})
 }
 
+func TestPortDetectionTypescriptExpressEnvDestructuring(t *testing.T) {
+	testPortDetectionInProject(t, "expressjs-typescript", []int{3020})
+}
+
+func TestPortDetectionJavascriptExpressESM(t *testing.T) {
+	testPortDetectionInProject(t, "expressjs-esm", []int{3021})
+	os.Setenv("TEST_EXPRESS_ESM_PORT", "3121")
+	testPortDetectionInProject(t, "expressjs-esm", []int{3121, 3021})
+	os.Unsetenv("TEST_EXPRESS_ESM_PORT")
+}
+
+func TestPortDetectionNestJs(t *testing.T) {
+	testPortDetectionInProject(t, "nodejs-nestjs", []int{3005})
+	os.Setenv("NEST_PORT", "3105")
+	testPortDetectionInProject(t, "nodejs-nestjs", []int{3105})
+	os.Unsetenv("NEST_PORT")
+}
+
+func TestPortDetectionFastify(t *testing.T) {
+	testPortDetectionInProject(t, "nodejs-fastify", []int{3006})
+}
+
+func TestPortDetectionKoa(t *testing.T) {
+	testPortDetectionInProject(t, "nodejs-koa", []int{3007})
+}
+
+func TestPortDetectionHapi(t *testing.T) {
+	testPortDetectionInProject(t, "nodejs-hapi", []int{3008})
+}
+
+func TestPortDetectionAdonisJs(t *testing.T) {
+	testPortDetectionInProject(t, "nodejs-adonisjs", []int{3333})
+}
+
+func TestPortDetectionViteInConfigFile(t *testing.T) {
+	testPortDetectionInProject(t, "vite-react", []int{3010})
+}
+
+func TestPortDetectionAstroPortInDevScript(t *testing.T) {
+	testPortDetectionInProject(t, "astro-app", []int{4322})
+}
+
+func TestPortDetectionSvelteKitDefaultPort(t *testing.T) {
+	testPortDetectionInProject(t, "sveltekit-app", []int{5173})
+}
+
+func TestPortDetectionGatsbyDefaultPort(t *testing.T) {
+	testPortDetectionInProject(t, "gatsby-app", []int{8000})
+}
+
+func TestPortDetectionRemixDefaultPort(t *testing.T) {
+	testPortDetectionInProject(t, "remix-app", []int{3000})
+}
+
+func TestPortDetectionSolidStartPortInDevScript(t *testing.T) {
+	testPortDetectionInProject(t, "solidstart-app", []int{3011})
+}
+
+func TestPortDetectionQwikInConfigFile(t *testing.T) {
+	testPortDetectionInProject(t, "qwik-app", []int{3012})
+}
+
+func TestPortDetectionJavascriptExpressDotEnv(t *testing.T) {
+	testPortDetectionInProject(t, "expressjs-dotenv", []int{3031})
+	os.Setenv("TEST_EXPRESS_DOTENV_PORT", "3131")
+	testPortDetectionInProject(t, "expressjs-dotenv", []int{3131})
+	os.Unsetenv("TEST_EXPRESS_DOTENV_PORT")
+}
+
+func TestPortDetectionNextJsPortInDotEnv(t *testing.T) {
+	testPortDetectionInProject(t, "nextjs-dotenv", []int{3032})
+}
+
+func TestComponentDetectionOnNxMonorepo(t *testing.T) {
+	isComponentsInProject(t, "nx-monorepo", 4, "TypeScript", "api")
+	testWorkspaceComponentInProject(t, "nx-monorepo", "api", false, []string{"data-access"}, []int{3333})
+	testWorkspaceComponentInProject(t, "nx-monorepo", "web", false, []string{"shared-ui"}, []int{4201})
+	testWorkspaceComponentInProject(t, "nx-monorepo", "shared-ui", true, nil, nil)
+	testWorkspaceComponentInProject(t, "nx-monorepo", "data-access", true, nil, nil)
+}
+
+func TestComponentDetectionOnNxMonorepoWithIgnoredBuildOutput(t *testing.T) {
+	// the build output ignored by the .gitignore of the monorepo is not scanned for imports
+	projectPath := filepath.Join(t.TempDir(), "nx-monorepo")
+	if err := os.CopyFS(projectPath, os.DirFS(getTestProjectPath("nx-monorepo"))); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, projectPath, ".gitignore", "dist\ncoverage\n")
+	if err := os.MkdirAll(filepath.Join(projectPath, "apps", "web", "dist"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(projectPath, "apps", "web", "dist"), "main.js", "import { db } from \"@nx-monorepo/data-access\";\n")
+	components := getComponentsFromProjectInner(t, projectPath)
+	verifyComponents(t, components, 4, "TypeScript", "api")
+	for _, component := range components {
+		if component.Name == "web" && (len(component.Dependencies) != 1 || component.Dependencies[0] != "shared-ui") {
+			t.Errorf("Expected component web to only depend on shared-ui but found %v", component.Dependencies)
+		}
+	}
+}
+
+func TestComponentDetectionOnTurborepo(t *testing.T) {
+	isComponentsInProject(t, "turbo-monorepo", 2, "JavaScript", "docs")
+	testWorkspaceComponentInProject(t, "turbo-monorepo", "docs", false, []string{"@repo/ui"}, []int{3040})
+	testWorkspaceComponentInProject(t, "turbo-monorepo", "@repo/ui", true, nil, nil)
+}
+
+func TestComponentDetectionOnLernaMonorepo(t *testing.T) {
+	isComponentsInProject(t, "lerna-monorepo", 2, "JavaScript", "@lerna-demo/server")
+	testWorkspaceComponentInProject(t, "lerna-monorepo", "@lerna-demo/server", false, []string{"@lerna-demo/utils"}, []int{3041})
+	testWorkspaceComponentInProject(t, "lerna-monorepo", "@lerna-demo/utils", true, nil, nil)
+}
+
+func TestComponentDetectionOnPnpmWorkspace(t *testing.T) {
+	isComponentsInProject(t, "pnpm-workspace", 3, "JavaScript", "@acme/api")
+	testWorkspaceComponentInProject(t, "pnpm-workspace", "@acme/api", false, []string{"@acme/logger"}, []int{3050})
+	testWorkspaceComponentInProject(t, "pnpm-workspace", "@acme/logger", true, nil, nil)
+	// folders excluded from the workspace are not part of the monorepo
+	testWorkspaceComponentInProject(t, "pnpm-workspace", "logger-test-fixture", false, nil, nil)
+}
+
+func TestComponentDetectionOnYarnWorkspaces(t *testing.T) {
+	isComponentsInProject(t, "yarn-workspaces", 2, "JavaScript", "@yarn-ws/ui")
+	testWorkspaceComponentInProject(t, "yarn-workspaces", "@yarn-ws/web", false, []string{"@yarn-ws/ui"}, []int{3051})
+	testWorkspaceComponentInProject(t, "yarn-workspaces", "@yarn-ws/ui", true, nil, nil)
+}
+
+func TestComponentDetectionOnYarnWorkspacesWithIgnoredPackage(t *testing.T) {
+	// a package ignored by the .gitignore of the workspace root is not a project of the workspace
+	projectPath := filepath.Join(t.TempDir(), "yarn-workspaces")
+	if err := os.CopyFS(projectPath, os.DirFS(getTestProjectPath("yarn-workspaces"))); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, projectPath, ".gitignore", "services/legacy\n")
+	legacyPath := filepath.Join(projectPath, "services", "legacy")
+	if err := os.MkdirAll(legacyPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, legacyPath, "package.json", `{"name": "@yarn-ws/legacy", "version": "1.0.0"}`)
+	writeTestFile(t, filepath.Join(projectPath, "services", "web"), "package.json", `{
+  "name": "@yarn-ws/web",
+  "scripts": {"dev": "vite --port 3051"},
+  "dependencies": {"@yarn-ws/ui": "1.0.0", "@yarn-ws/legacy": "1.0.0"},
+  "devDependencies": {"vite": "^5.0.8"}
+}`)
+	components := getComponentsFromProjectInner(t, projectPath)
+	verifyComponents(t, components, 2, "JavaScript", "")
+	for _, component := range components {
+		if component.Name == "@yarn-ws/web" && (len(component.Dependencies) != 1 || component.Dependencies[0] != "@yarn-ws/ui") {
+			t.Errorf("Expected component @yarn-ws/web to only depend on @yarn-ws/ui but found %v", component.Dependencies)
+		}
+	}
+}
+
 func TestPortDetectionNextJsPortInStartScript(t *testing.T) {
 	testPortDetectionInProject(t, "nextjs-app", []int{8610})
 }
@@ -357,6 +907,10 @@ func TestPortDetectionVuePortEnvDockerfile(t *testing.T) {
 	testPortDetectionInProject(t, "vue-app-dockerfile-simple", []int{4526})
 }
 
+func TestPortDetectionViteDefaultPortWithVue(t *testing.T) {
+	testPortDetectionInProject(t, "vite-vue-default-port", []int{5173})
+}
+
 // component detection: php
 func TestComponentDetectionOnLaravel(t *testing.T) {
 	isComponentsInProject(t, "laravel", 1, "PHP", "laravel")
@@ -369,13 +923,57 @@ func TestPortDetectionPHPLaravel(t *testing.T) {
 
 // component detection: python
 func TestComponentDetectionOnDjango(t *testing.T) {
-	isComponentsInProject(t, "django", 1, "python", "django")
+	isComponentsInProject(t, "django", 1, "python", "project")
 }
 
 func TestComponentDetectionOnFlask(t *testing.T) {
 	isComponentsInProject(t, "flask", 1, "python", "flask")
 }
 
+func TestComponentDetectionOnFastAPI(t *testing.T) {
+	isComponentsInProject(t, "python-fastapi", 1, "python", "python-fastapi")
+}
+
+func TestComponentDetectionOnStarlette(t *testing.T) {
+	isComponentsInProject(t, "python-starlette-procfile", 1, "python", "python-starlette-procfile")
+}
+
+func TestComponentDetectionOnTornado(t *testing.T) {
+	isComponentsInProject(t, "python-tornado", 1, "python", "python-tornado")
+}
+
+func TestComponentDetectionOnAioHttp(t *testing.T) {
+	isComponentsInProject(t, "python-aiohttp", 1, "python", "python-aiohttp")
+}
+
+func TestComponentDetectionOnSanic(t *testing.T) {
+	isComponentsInProject(t, "python-sanic", 1, "python", "python-sanic")
+}
+
+func TestComponentDetectionOnQuart(t *testing.T) {
+	isComponentsInProject(t, "python-quart", 1, "python", "quart-hello")
+}
+
+func TestComponentDetectionOnPipenv(t *testing.T) {
+	isComponentsInProject(t, "python-pipenv", 1, "python", "python-pipenv")
+}
+
+func TestComponentDetectionOnConda(t *testing.T) {
+	isComponentsInProject(t, "python-conda", 1, "python", "python-conda")
+}
+
+func TestComponentDetectionOnPoetry(t *testing.T) {
+	isComponentsInProject(t, "python-poetry", 1, "python", "poetry-app")
+}
+
+func TestComponentDetectionOnSetuptools(t *testing.T) {
+	isComponentsInProject(t, "python-setuptools", 1, "python", "setuptools-app")
+}
+
+func TestComponentDetectionOnSetupCfg(t *testing.T) {
+	isComponentsInProject(t, "python-setup-cfg", 1, "python", "setup-cfg-app")
+}
+
 // port detection: python
 func TestPortDetectionDjango(t *testing.T) {
 	testPortDetectionInProject(t, "django", []int{3543})
@@ -393,6 +991,67 @@ func TestPortDetectionFlaskStringValue(t *testing.T) {
 	testPortDetectionInProject(t, "flask-port-string-value", []int{})
 }
 
+func TestPortDetectionFastAPI(t *testing.T) {
+	testPortDetectionInProject(t, "python-fastapi", []int{8001})
+}
+
+func TestPortDetectionFastAPIDockerfileCommand(t *testing.T) {
+	testPortDetectionInProject(t, "python-fastapi-docker-cmd", []int{8002})
+}
+
+func TestPortDetectionFastAPIWithShellScript(t *testing.T) {
+	// the --port argument of pg_isready inside wait-for-db.sh is not the port of the server
+	testPortDetectionInProject(t, "python-fastapi-wait-for-db", []int{8003})
+}
+
+func TestPortDetectionStarletteProcfile(t *testing.T) {
+	testPortDetectionInProject(t, "python-starlette-procfile", []int{8003})
+}
+
+func TestPortDetectionTornado(t *testing.T) {
+	testPortDetectionInProject(t, "python-tornado", []int{8888})
+}
+
+func TestPortDetectionTornadoIgnoresSocketListen(t *testing.T) {
+	testPortDetectionInProject(t, "python-tornado-socket", []int{8890})
+}
+
+func TestPortDetectionAioHttp(t *testing.T) {
+	testPortDetectionInProject(t, "python-aiohttp", []int{8084})
+}
+
+func TestPortDetectionSanic(t *testing.T) {
+	testPortDetectionInProject(t, "python-sanic", []int{8085})
+}
+
+func TestPortDetectionQuart(t *testing.T) {
+	testPortDetectionInProject(t, "python-quart", []int{8086})
+}
+
+func TestPortDetectionPipenv(t *testing.T) {
+	testPortDetectionInProject(t, "python-pipenv", []int{8087})
+}
+
+func TestPortDetectionConda(t *testing.T) {
+	testPortDetectionInProject(t, "python-conda", []int{8088})
+}
+
+func TestPortDetectionGunicornConf(t *testing.T) {
+	testPortDetectionInProject(t, "python-gunicorn-conf", []int{8091})
+}
+
+func TestPortDetectionGunicornProcfile(t *testing.T) {
+	testPortDetectionInProject(t, "python-gunicorn-procfile", []int{8092})
+}
+
+func TestPortDetectionGunicornCmdArgs(t *testing.T) {
+	testPortDetectionInProject(t, "python-gunicorn-cmd-args", []int{8093})
+}
+
+func TestPortDetectionUwsgi(t *testing.T) {
+	testPortDetectionInProject(t, "python-uwsgi", []int{8094})
+}
+
 // component detection: corner cases
 func TestComponentDetectionNoResult(t *testing.T) {
 	components := getComponentsFromTestProject(t, "simple")
@@ -455,7 +1114,7 @@ func TestComponentDetectionWithGitIgnoreRule(t *testing.T) {
 
 func TestComponentDetectionMultiProjects(t *testing.T) {
 	components := getComponentsFromTestProject(t, "")
-	nComps := 79
+	nComps := 184
 	if len(components) != nComps {
 		t.Errorf("Expected %v components but found %v", strconv.Itoa(nComps), strconv.Itoa(len(components)))
 	}
@@ -11,6 +11,7 @@
 package recognizer
 
 import (
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -18,6 +19,10 @@ import (
 	"github.com/devfile/alizer/pkg/apis/recognizer"
 )
 
+func TestAnalyzeOnGradleVersionCatalog(t *testing.T) {
+	isLanguageInProject(t, "gradle-version-catalog", "java", []string{"gradle"}, []string{"spring", "spring boot"})
+}
+
 func TestAnalyzeOnMicronaut(t *testing.T) {
 	isLanguageInProject(t, "micronaut", "java", []string{"maven"}, []string{"micronaut"})
 }
@@ -30,6 +35,119 @@ func TestAnalyzeOnJavascript(t *testing.T) {
 	isLanguageInProject(t, "nodejs-ex", "javascript", []string{"nodejs"}, []string{"express"})
 }
 
+func TestAnalyzeOnNestJs(t *testing.T) {
+	isLanguageInProject(t, "nodejs-nestjs", "typescript", []string{"nodejs"}, []string{"nestjs"})
+}
+
+func TestAnalyzeOnFastify(t *testing.T) {
+	isLanguageInProject(t, "nodejs-fastify", "javascript", []string{"nodejs"}, []string{"fastify"})
+}
+
+func TestAnalyzeOnKoa(t *testing.T) {
+	isLanguageInProject(t, "nodejs-koa", "javascript", []string{"nodejs"}, []string{"koa"})
+}
+
+func TestAnalyzeOnHapi(t *testing.T) {
+	isLanguageInProject(t, "nodejs-hapi", "javascript", []string{"nodejs"}, []string{"hapi"})
+}
+
+func TestAnalyzeOnAdonisJs(t *testing.T) {
+	isLanguageInProject(t, "nodejs-adonisjs", "typescript", []string{"nodejs"}, []string{"adonisjs"})
+}
+
+func TestAnalyzeOnVite(t *testing.T) {
+	isLanguageInProject(t, "vite-react", "javascript", []string{"nodejs"}, []string{"vite", "react"})
+}
+
+func TestAnalyzeOnAstro(t *testing.T) {
+	isLanguageInProject(t, "astro-app", "javascript", []string{"nodejs"}, []string{"astro"})
+}
+
+func TestAnalyzeOnSvelteKit(t *testing.T) {
+	isLanguageInProject(t, "sveltekit-app", "javascript", []string{"nodejs"}, []string{"sveltekit", "svelte", "vite"})
+}
+
+func TestAnalyzeOnGatsby(t *testing.T) {
+	isLanguageInProject(t, "gatsby-app", "javascript", []string{"nodejs"}, []string{"gatsby"})
+}
+
+func TestAnalyzeOnRemix(t *testing.T) {
+	isLanguageInProject(t, "remix-app", "javascript", []string{"nodejs"}, []string{"remix"})
+}
+
+func TestAnalyzeOnSolidStart(t *testing.T) {
+	isLanguageInProject(t, "solidstart-app", "javascript", []string{"nodejs"}, []string{"solidstart"})
+}
+
+func TestAnalyzeOnQwik(t *testing.T) {
+	isLanguageInProject(t, "qwik-app", "javascript", []string{"nodejs"}, []string{"qwik"})
+}
+
+func TestAnalyzeOnNpm(t *testing.T) {
+	isLanguageInProject(t, "expressjs", "javascript", []string{"nodejs", "npm"}, []string{"express"})
+}
+
+func TestAnalyzeOnYarn(t *testing.T) {
+	isLanguageInProject(t, "nodejs-yarn", "javascript", []string{"nodejs", "yarn"}, []string{"express"})
+}
+
+func TestAnalyzeOnYarnBerry(t *testing.T) {
+	isLanguageInProject(t, "nodejs-yarn-berry", "javascript", []string{"nodejs", "yarn berry"}, []string{"express"})
+}
+
+func TestAnalyzeOnPnpm(t *testing.T) {
+	isLanguageInProject(t, "nodejs-pnpm", "javascript", []string{"nodejs", "pnpm"}, []string{"express"})
+}
+
+func TestAnalyzeOnNx(t *testing.T) {
+	isLanguageInProject(t, "nx-monorepo", "typescript", []string{"nodejs", "npm", "nx"}, []string{"next"})
+}
+
+func TestAnalyzeOnTurborepo(t *testing.T) {
+	isLanguageInProject(t, "turbo-monorepo", "javascript", []string{"nodejs", "npm", "turborepo"}, []string{})
+}
+
+func TestAnalyzeOnLerna(t *testing.T) {
+	isLanguageInProject(t, "lerna-monorepo", "javascript", []string{"nodejs", "npm", "lerna"}, []string{})
+}
+
+func TestAnalyzeOnPnpmWorkspace(t *testing.T) {
+	isLanguageInProject(t, "pnpm-workspace", "javascript", []string{"nodejs", "pnpm"}, []string{})
+}
+
+func TestAnalyzeFileOnPnpmWorkspacePackage(t *testing.T) {
+	// the lockfile of a workspace package is at the root of the workspace
+	language, err := recognizer.AnalyzeFile(filepath.Join(getTestProjectPath("pnpm-workspace"), "apps", "api", "package.json"), "JavaScript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hasWantedTools(language, []string{"nodejs", "pnpm"}) {
+		t.Errorf("Expected Node.js and pnpm tools but found %v", language.Tools)
+	}
+}
+
+func TestAnalyzeFileOnNxProjectOutsideWorkspace(t *testing.T) {
+	// without a workspace root the lockfile is looked up next to the project.json
+	projectPath := t.TempDir()
+	writeTestFile(t, projectPath, "project.json", `{"name": "standalone"}`)
+	writeTestFile(t, projectPath, "pnpm-lock.yaml", "lockfileVersion: '9.0'\n")
+	language, err := recognizer.AnalyzeFile(filepath.Join(projectPath, "project.json"), "JavaScript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hasWantedTools(language, []string{"nodejs", "pnpm"}) {
+		t.Errorf("Expected Node.js and pnpm tools but found %v", language.Tools)
+	}
+}
+
+func TestAnalyzeOnBun(t *testing.T) {
+	isLanguageInProject(t, "nodejs-bun", "javascript", []string{"bun"}, []string{"express"})
+}
+
+func TestAnalyzeOnDeno(t *testing.T) {
+	isLanguageInProject(t, "nodejs-deno", "javascript", []string{"deno"}, []string{"express"})
+}
+
 func TestAnalyzeOnDjango(t *testing.T) {
 	isLanguageInProject(t, "django", "python", []string{}, []string{"django"})
 }
@@ -50,6 +168,137 @@ func TestAnalyzeOnGoGin(t *testing.T) {
 	isLanguageInProject(t, "golang-gin-app", "go", []string{"1.15"}, []string{"gin"})
 }
 
+func TestAnalyzeOnChi(t *testing.T) {
+	isLanguageInProject(t, "go-chi", "go", []string{"1.21"}, []string{"chi"})
+}
+
+func TestAnalyzeOnIris(t *testing.T) {
+	isLanguageInProject(t, "go-iris", "go", []string{"1.21"}, []string{"iris"})
+}
+
+func TestAnalyzeOnHertz(t *testing.T) {
+	isLanguageInProject(t, "go-hertz", "go", []string{"1.21"}, []string{"hertz"})
+}
+
+func TestAnalyzeOnKratos(t *testing.T) {
+	isLanguageInProject(t, "go-kratos", "go", []string{"1.21"}, []string{"kratos", "grpc"})
+}
+
+func TestAnalyzeOnBuffalo(t *testing.T) {
+	isLanguageInProject(t, "go-buffalo", "go", []string{"1.21"}, []string{"buffalo"})
+}
+
+func TestAnalyzeOnGrpc(t *testing.T) {
+	isLanguageInProject(t, "go-grpc", "go", []string{"1.21"}, []string{"grpc"})
+}
+
+func TestAnalyzeOnGoNetHttp(t *testing.T) {
+	isLanguageInProject(t, "golang-runtime", "go", []string{"1.19"}, []string{"net/http"})
+}
+
+func TestAnalyzeOnKtor(t *testing.T) {
+	isLanguageInProject(t, "ktor", "kotlin", []string{"gradle"}, []string{"ktor"})
+}
+
+func TestAnalyzeOnKotlinMixedWithJava(t *testing.T) {
+	languages, err := recognizer.Analyze(getTestProjectPath("kotlin-java-mixed"))
+	if err != nil {
+		t.Error(err)
+	}
+
+	occurrences := make(map[string]int)
+	for _, language := range languages {
+		occurrences[language.Name]++
+	}
+	for _, name := range []string{"Kotlin", "Java"} {
+		if occurrences[name] != 1 {
+			t.Errorf("Expected %v language once but found it %v times", name, occurrences[name])
+		}
+	}
+	isLanguageInProject(t, "kotlin-java-mixed", "kotlin", []string{"gradle"}, []string{"ktor"})
+	isLanguageInProject(t, "kotlin-java-mixed", "java", []string{"gradle"}, []string{"ktor"})
+}
+
+func TestAnalyzeOnPlay(t *testing.T) {
+	isLanguageInProject(t, "scala-play", "scala", []string{"sbt"}, []string{"play"})
+}
+
+func TestAnalyzeOnAkkaHttp(t *testing.T) {
+	isLanguageInProject(t, "scala-akka-http", "scala", []string{"sbt"}, []string{"akka http"})
+}
+
+func TestAnalyzeOnFastAPI(t *testing.T) {
+	isLanguageInProject(t, "python-fastapi", "python", []string{}, []string{"fastapi"})
+}
+
+func TestAnalyzeOnStarlette(t *testing.T) {
+	isLanguageInProject(t, "python-starlette-procfile", "python", []string{}, []string{"starlette"})
+}
+
+func TestAnalyzeOnPoetry(t *testing.T) {
+	isLanguageInProject(t, "python-poetry", "python", []string{"poetry"}, []string{"flask"})
+}
+
+func TestAnalyzeOnPipenv(t *testing.T) {
+	isLanguageInProject(t, "python-pipenv", "python", []string{"pipenv"}, []string{"fastapi"})
+}
+
+func TestAnalyzeOnConda(t *testing.T) {
+	isLanguageInProject(t, "python-conda", "python", []string{"conda"}, []string{"aiohttp"})
+}
+
+func TestAnalyzeOnAioHttpClientLibrary(t *testing.T) {
+	isLanguageInProject(t, "python-tornado-socket", "python", []string{"pip"}, []string{"tornado"})
+	languages, err := recognizer.Analyze(getTestProjectPath("python-tornado-socket"))
+	if err != nil {
+		t.Error(err)
+	}
+	for _, language := range languages {
+		if hasWantedFramework(language, "aiohttp") {
+			t.Errorf("aiohttp-retry should not be detected as the aiohttp framework")
+		}
+	}
+}
+
+func TestAnalyzeOnPip(t *testing.T) {
+	isLanguageInProject(t, "python-fastapi", "python", []string{"pip"}, []string{"fastapi"})
+}
+
+func TestAnalyzeOnRails(t *testing.T) {
+	isLanguageInProject(t, "ruby-rails", "ruby", []string{"bundler"}, []string{"rails"})
+}
+
+func TestAnalyzeOnSinatra(t *testing.T) {
+	isLanguageInProject(t, "ruby-sinatra", "ruby", []string{"bundler"}, []string{"sinatra"})
+}
+
+func TestAnalyzeFileOnSinatraGemspec(t *testing.T) {
+	// only the .gemspec is analyzed, the Gemfile next to it still shows that Bundler is used
+	language, err := recognizer.AnalyzeFile(filepath.Join(getTestProjectPath("ruby-sinatra-gem"), "sinatra-status.gemspec"), "Ruby")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hasWantedTools(language, []string{"bundler"}) || !hasWantedFrameworks(language, []string{"sinatra"}) {
+		t.Errorf("Expected Bundler tool and Sinatra framework but found %v and %v", language.Tools, language.Frameworks)
+	}
+}
+
+func TestAnalyzeOnHanami(t *testing.T) {
+	isLanguageInProject(t, "ruby-hanami", "ruby", []string{"bundler"}, []string{"hanami"})
+}
+
+func TestAnalyzeOnRustActix(t *testing.T) {
+	isLanguageInProject(t, "rust-actix", "rust", []string{"cargo"}, []string{"actix web"})
+}
+
+func TestAnalyzeOnRustAxum(t *testing.T) {
+	isLanguageInProject(t, "rust-axum", "rust", []string{"cargo"}, []string{"axum", "tokio"})
+}
+
+func TestAnalyzeOnCargoWorkspace(t *testing.T) {
+	isLanguageInProject(t, "rust-workspace", "rust", []string{"cargo"}, []string{"axum", "tokio"})
+}
+
 func TestAnalyzeJSStaticFilesInJavaApp(t *testing.T) {
 	isLanguageInProject(t, "js-static-files-in-java-app", "java", []string{}, []string{})
 }
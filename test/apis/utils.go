@@ -100,6 +100,28 @@ func testPortDetectionInProject(t *testing.T, project string, ports []int) {
 	}
 }
 
+// testWorkspaceComponentInProject checks the kind, dependencies and ports of the component named name in a monorepo
+func testWorkspaceComponentInProject(t *testing.T, project string, name string, library bool, dependencies []string, ports []int) {
+	components := getComponentsFromTestProject(t, project)
+	for _, component := range components {
+		if component.Name != name {
+			continue
+		}
+		assert.Equal(t, library, component.Library, "component %s has an unexpected library flag", name)
+		assert.ElementsMatch(t, dependencies, component.Dependencies, "component %s has unexpected dependencies", name)
+		assert.ElementsMatch(t, ports, component.Ports, "component %s has unexpected ports", name)
+		return
+	}
+	t.Errorf("Component %s not found in project %s", name, project)
+}
+
+// testParentOfComponentsInProject checks that all components of a multi-module build have the same parent
+func testParentOfComponentsInProject(t *testing.T, project string, parent string) {
+	for _, component := range getComponentsFromTestProject(t, project) {
+		assert.Equal(t, parent, component.Parent, "component %s has an unexpected parent", component.Name)
+	}
+}
+
 func getTestProjectPath(folder string) string {
 	_, b, _, _ := runtime.Caller(0)
 	basepath := filepath.Dir(b)